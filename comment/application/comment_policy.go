@@ -0,0 +1,17 @@
+package application
+
+import userModel "issue-service-aoroa/user/model"
+
+// 역할별 댓글 권한
+//   - admin, member: 작성, 그리고 자기 댓글의 수정·삭제
+//   - reporter: admin, member와 같다. 등록한 이슈를 두고 논의할 수 있어야 하기 때문이다
+//   - viewer: 조회만
+//
+// 남의 댓글을 고칠 수 없는 것은 역할과 무관하며 model.Comment가 검사한다.
+
+func authorizeCommentWrite(user *userModel.User) error {
+	if user.Role == userModel.RoleViewer {
+		return ErrCommentReadOnlyRole
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package application
+
+import (
+	"log"
+	"time"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/comment/model"
+	issueApp "issue-service-aoroa/issue/application"
+	issueInfra "issue-service-aoroa/issue/infrastructure"
+	issueModel "issue-service-aoroa/issue/model"
+	mentionApp "issue-service-aoroa/mention/application"
+	userInfra "issue-service-aoroa/user/infrastructure"
+	userModel "issue-service-aoroa/user/model"
+)
+
+// 댓글은 이슈 필드가 아니므로 완료·취소된 이슈에도 달고 고칠 수 있다. 삭제된 이슈에는 쓸 수 없고 읽기만 된다
+type CommentService interface {
+	AddComment(issueID uint, cmd AddCommentCommand) (*model.Comment, error)
+	GetComments(issueID uint) ([]model.Thread, error)
+	EditComment(issueID, commentID uint, cmd EditCommentCommand) (*model.Comment, error)
+	DeleteComment(issueID, commentID, userID uint) error
+	GetCommentRevisions(issueID, commentID uint) ([]model.Revision, error)
+}
+
+// ParentID가 있으면 해당 댓글에 다는 답글이다
+type AddCommentCommand struct {
+	UserID   uint
+	Body     string
+	ParentID *uint
+}
+
+type EditCommentCommand struct {
+	UserID uint
+	Body   string
+}
+
+type commentService struct {
+	commentRepo commentInfra.CommentRepository
+	issueRepo   issueInfra.IssueRepository
+	userRepo    userInfra.UserRepository
+	mentions    mentionApp.MentionService
+}
+
+func NewCommentService(commentRepo commentInfra.CommentRepository, issueRepo issueInfra.IssueRepository, userRepo userInfra.UserRepository, mentions mentionApp.MentionService) CommentService {
+	return &commentService{
+		commentRepo: commentRepo,
+		issueRepo:   issueRepo,
+		userRepo:    userRepo,
+		mentions:    mentions,
+	}
+}
+
+func (s *commentService) AddComment(issueID uint, cmd AddCommentCommand) (*model.Comment, error) {
+	if err := s.checkWritableIssue(issueID); err != nil {
+		return nil, err
+	}
+	author, err := s.findWriter(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var parent *model.Comment
+	if cmd.ParentID != nil {
+		if parent, err = s.findComment(issueID, *cmd.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	comment, err := model.NewComment(issueID, *author, cmd.Body, parent, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.commentRepo.Create(*comment)
+	if err != nil {
+		return nil, err
+	}
+	s.recordMentions(created)
+	return &created, nil
+}
+
+func (s *commentService) GetComments(issueID uint) ([]model.Thread, error) {
+	if _, err := s.findIssue(issueID); err != nil {
+		return nil, err
+	}
+	comments, err := s.commentRepo.ListByIssue(issueID)
+	if err != nil {
+		return nil, err
+	}
+	return model.Threads(comments), nil
+}
+
+func (s *commentService) EditComment(issueID, commentID uint, cmd EditCommentCommand) (*model.Comment, error) {
+	if err := s.checkWritableIssue(issueID); err != nil {
+		return nil, err
+	}
+	if _, err := s.findWriter(cmd.UserID); err != nil {
+		return nil, err
+	}
+	comment, err := s.findComment(issueID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := comment.Edit(cmd.UserID, cmd.Body, time.Now())
+	if err != nil || !changed {
+		return comment, err
+	}
+	return s.update(*comment)
+}
+
+func (s *commentService) DeleteComment(issueID, commentID, userID uint) error {
+	if err := s.checkWritableIssue(issueID); err != nil {
+		return err
+	}
+	if _, err := s.findWriter(userID); err != nil {
+		return err
+	}
+	comment, err := s.findComment(issueID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if err := comment.Delete(userID, time.Now()); err != nil {
+		return err
+	}
+	_, err = s.update(*comment)
+	return err
+}
+
+// 본문이 바뀌었으므로 멘션도 새 본문 기준으로 다시 기록한다
+func (s *commentService) update(comment model.Comment) (*model.Comment, error) {
+	updated, err := s.commentRepo.Update(comment)
+	if err != nil || updated == nil {
+		return updated, err
+	}
+	s.recordMentions(*updated)
+	return updated, nil
+}
+
+// 멘션은 본문에서 다시 만들 수 있는 색인이다. 댓글은 이미 저장되었으므로
+// 기록하지 못해도 요청을 실패로 응답하지 않고 로그만 남긴다
+func (s *commentService) recordMentions(comment model.Comment) {
+	if err := s.mentions.RecordCommentMentions(comment); err != nil {
+		log.Printf("댓글 %d의 멘션을 기록하지 못했습니다: %v", comment.ID, err)
+	}
+}
+
+// 삭제된 댓글의 이력은 저장소에만 남고 API로는 보여주지 않는다
+func (s *commentService) GetCommentRevisions(issueID, commentID uint) ([]model.Revision, error) {
+	if _, err := s.findIssue(issueID); err != nil {
+		return nil, err
+	}
+	comment, err := s.findComment(issueID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsDeleted() {
+		return nil, model.ErrCommentDeleted
+	}
+
+	revisions := comment.Revisions
+	if revisions == nil {
+		revisions = []model.Revision{}
+	}
+	return revisions, nil
+}
+
+func (s *commentService) findIssue(issueID uint) (*issueModel.Issue, error) {
+	issue, err := s.issueRepo.GetByID(issueID)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		return nil, issueApp.ErrIssueNotFound
+	}
+	return issue, nil
+}
+
+func (s *commentService) checkWritableIssue(issueID uint) error {
+	issue, err := s.findIssue(issueID)
+	if err != nil {
+		return err
+	}
+	if issue.IsDeleted() {
+		return issueModel.ErrIssueDeleted
+	}
+	return nil
+}
+
+// 다른 이슈의 댓글은 없는 댓글로 본다
+func (s *commentService) findComment(issueID, commentID uint) (*model.Comment, error) {
+	comment, err := s.commentRepo.GetByID(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment == nil || comment.IssueID != issueID {
+		return nil, ErrCommentNotFound
+	}
+	return comment, nil
+}
+
+// 댓글을 작성·수정·삭제하는 사용자를 찾고 역할로 권한을 검사한다
+func (s *commentService) findWriter(userID uint) (*userModel.User, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, issueApp.ErrUserNotFound
+	}
+	if err := authorizeCommentWrite(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,376 @@
+package application
+
+import (
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/comment/model"
+	"issue-service-aoroa/database"
+	"issue-service-aoroa/database/migrations"
+	issueApp "issue-service-aoroa/issue/application"
+	issueInfra "issue-service-aoroa/issue/infrastructure"
+	issueModel "issue-service-aoroa/issue/model"
+	mentionApp "issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	userInfra "issue-service-aoroa/user/infrastructure"
+	userModel "issue-service-aoroa/user/model"
+)
+
+// 이슈는 관리자인 1번 사용자로 만들고 고친다
+func asAdmin(issues issueApp.IssueService) issueApp.IssueService {
+	return issues.WithAudit(issueApp.AuditContext{Actor: &userModel.User{ID: 1, Name: "김개발", Role: userModel.RoleAdmin}})
+}
+
+type testServices struct {
+	comments    CommentService
+	issues      issueApp.IssueService
+	mentions    mentionApp.MentionService
+	commentRepo commentInfra.CommentRepository
+	userRepo    userInfra.UserRepository
+}
+
+func newMemoryServices(t *testing.T) testServices {
+	issueRepo, userRepo, commentRepo := issueInfra.NewIssueRepository(), userInfra.NewUserRepository(), commentInfra.NewCommentRepository()
+	mentions := mentionApp.NewMentionService(mentionInfra.NewMentionRepository(), issueRepo, userRepo)
+	return testServices{
+		comments:    NewCommentService(commentRepo, issueRepo, userRepo, mentions),
+		issues:      asAdmin(issueApp.NewIssueService(issueRepo, userRepo, commentRepo, mentions)),
+		mentions:    mentions,
+		commentRepo: commentRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func openMigratedDB(t *testing.T, open func(path string) (*sql.DB, error)) *sql.DB {
+	db, err := open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("SQLite 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	all, err := migrations.Load()
+	if err != nil {
+		t.Fatalf("마이그레이션 로드 실패: %v", err)
+	}
+	if _, err := migrations.NewMigrator(db, all).Up(); err != nil {
+		t.Fatalf("마이그레이션 적용 실패: %v", err)
+	}
+	return db
+}
+
+func newSQLiteServices(t *testing.T) testServices {
+	db := openMigratedDB(t, database.OpenSQLite)
+	userRepo, err := userInfra.NewSQLiteUserRepository(db)
+	if err != nil {
+		t.Fatalf("사용자 저장소 생성 실패: %v", err)
+	}
+	issueRepo, err := issueInfra.NewSQLiteIssueRepository(db)
+	if err != nil {
+		t.Fatalf("이슈 저장소 생성 실패: %v", err)
+	}
+	commentRepo := commentInfra.NewSQLiteCommentRepository(db)
+	mentions := mentionApp.NewMentionService(mentionInfra.NewSQLiteMentionRepository(db), issueRepo, userRepo)
+	return testServices{
+		comments:    NewCommentService(commentRepo, issueRepo, userRepo, mentions),
+		issues:      asAdmin(issueApp.NewIssueService(issueRepo, userRepo, commentRepo, mentions)),
+		mentions:    mentions,
+		commentRepo: commentRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// eventlog 저장소 구성: 이슈는 이벤트 로그에, 사용자·댓글·멘션은 외래 키 검사 없이 SQLite에 둔다
+func newEventLogServices(t *testing.T) testServices {
+	db := openMigratedDB(t, database.OpenSQLiteWithoutForeignKeys)
+	userRepo, err := userInfra.NewSQLiteUserRepository(db)
+	if err != nil {
+		t.Fatalf("사용자 저장소 생성 실패: %v", err)
+	}
+	log, err := issueInfra.OpenFileEventLog(filepath.Join(t.TempDir(), "events.log"))
+	if err != nil {
+		t.Fatalf("이벤트 로그 열기 실패: %v", err)
+	}
+	t.Cleanup(func() { log.Close() })
+	issueRepo, err := issueInfra.NewEventSourcedIssueRepository(log)
+	if err != nil {
+		t.Fatalf("이슈 저장소 생성 실패: %v", err)
+	}
+	commentRepo := commentInfra.NewSQLiteCommentRepository(db)
+	mentions := mentionApp.NewMentionService(mentionInfra.NewSQLiteMentionRepository(db), issueRepo, userRepo)
+	return testServices{
+		comments:    NewCommentService(commentRepo, issueRepo, userRepo, mentions),
+		issues:      asAdmin(issueApp.NewIssueService(issueRepo, userRepo, commentRepo, mentions)),
+		mentions:    mentions,
+		commentRepo: commentRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// 모든 저장소 구현체에 대해 동일한 테스트를 실행한다
+func forEachRepository(t *testing.T, test func(t *testing.T, s testServices)) {
+	factories := []struct {
+		name string
+		new  func(t *testing.T) testServices
+	}{
+		{name: "memory", new: newMemoryServices},
+		{name: "sqlite", new: newSQLiteServices},
+		{name: "eventlog", new: newEventLogServices},
+	}
+	for _, factory := range factories {
+		t.Run(factory.name, func(t *testing.T) {
+			test(t, factory.new(t))
+		})
+	}
+}
+
+func createIssue(t *testing.T, s testServices) *issueModel.Issue {
+	t.Helper()
+	userID := uint(1)
+	issue, err := s.issues.CreateIssue("로그인 오류", "설명", &userID)
+	if err != nil {
+		t.Fatalf("이슈 생성 실패: %v", err)
+	}
+	return issue
+}
+
+func TestAddComment_성공_완료된_이슈에도_댓글과_답글(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, s testServices) {
+		issue := createIssue(t, s)
+		if _, err := s.issues.UpdateIssue(issue.ID, issueModel.NewUpdateCommand().WithStatus(issueModel.StatusCompleted), nil); err != nil {
+			t.Fatalf("이슈 완료 실패: %v", err)
+		}
+
+		comment, err := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 2, Body: "확인했습니다"})
+		if err != nil {
+			t.Fatalf("완료된 이슈에도 댓글을 달 수 있어야 함: %v", err)
+		}
+		if comment.ID == 0 || comment.Author.Name != "이디자인" || comment.Version != 1 {
+			t.Errorf("작성자와 ID가 채워져야 함: %+v", comment)
+		}
+
+		reply, err := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 1, Body: "감사합니다", ParentID: &comment.ID})
+		if err != nil {
+			t.Fatalf("답글을 달 수 있어야 함: %v", err)
+		}
+
+		threads, _ := s.comments.GetComments(issue.ID)
+		if len(threads) != 1 || len(threads[0].Replies) != 1 || threads[0].Replies[0].ID != reply.ID {
+			t.Errorf("답글이 스레드로 묶여야 함: %+v", threads)
+		}
+	})
+}
+
+func TestAddComment_실패(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, s testServices) {
+		issue := createIssue(t, s)
+		other := createIssue(t, s)
+		otherComment, _ := s.comments.AddComment(other.ID, AddCommentCommand{UserID: 1, Body: "다른 이슈의 댓글"})
+
+		if _, err := s.comments.AddComment(999, AddCommentCommand{UserID: 1, Body: "본문"}); !errors.Is(err, issueApp.ErrIssueNotFound) {
+			t.Errorf("없는 이슈는 ISSUE_NOT_FOUND여야 함. 실제: %v", err)
+		}
+		if _, err := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 999, Body: "본문"}); !errors.Is(err, issueApp.ErrUserNotFound) {
+			t.Errorf("없는 사용자는 USER_NOT_FOUND여야 함. 실제: %v", err)
+		}
+		if _, err := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 1, Body: "답글", ParentID: &otherComment.ID}); !errors.Is(err, ErrCommentNotFound) {
+			t.Errorf("다른 이슈의 댓글에는 답글을 달 수 없어야 함. 실제: %v", err)
+		}
+
+		s.issues.DeleteIssue(issue.ID, nil)
+		if _, err := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 1, Body: "본문"}); !errors.Is(err, issueModel.ErrIssueDeleted) {
+			t.Errorf("삭제된 이슈에는 댓글을 달 수 없어야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestEditComment_수정_이력이_저장됨(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, s testServices) {
+		issue := createIssue(t, s)
+		comment, _ := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 1, Body: "처음"})
+
+		if _, err := s.comments.EditComment(issue.ID, comment.ID, EditCommentCommand{UserID: 1, Body: "두 번째"}); err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		edited, err := s.comments.EditComment(issue.ID, comment.ID, EditCommentCommand{UserID: 1, Body: "세 번째"})
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if edited.Body != "세 번째" || edited.Version != 3 {
+			t.Errorf("수정한 본문과 버전이어야 함: %+v", edited)
+		}
+
+		revisions, _ := s.comments.GetCommentRevisions(issue.ID, comment.ID)
+		if len(revisions) != 2 || revisions[0].Body != "처음" || revisions[1].Body != "두 번째" {
+			t.Errorf("이전 본문이 순서대로 남아야 함: %+v", revisions)
+		}
+
+		if _, err := s.comments.EditComment(issue.ID, comment.ID, EditCommentCommand{UserID: 2, Body: "남의 댓글"}); !errors.Is(err, model.ErrNotCommentAuthor) {
+			t.Errorf("작성자가 아니면 수정할 수 없어야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestDeleteComment_자리는_남기고_이력은_숨김(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, s testServices) {
+		issue := createIssue(t, s)
+		comment, _ := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 1, Body: "지울 댓글"})
+		s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 2, Body: "답글", ParentID: &comment.ID})
+
+		if err := s.comments.DeleteComment(issue.ID, comment.ID, 1); err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		threads, _ := s.comments.GetComments(issue.ID)
+		if len(threads) != 1 || !threads[0].Comment.IsDeleted() || threads[0].Comment.Body != "" || len(threads[0].Replies) != 1 {
+			t.Errorf("삭제된 댓글은 본문 없이 답글과 함께 남아야 함: %+v", threads)
+		}
+		if _, err := s.comments.GetCommentRevisions(issue.ID, comment.ID); !errors.Is(err, model.ErrCommentDeleted) {
+			t.Errorf("삭제된 댓글의 이력은 조회할 수 없어야 함. 실제: %v", err)
+		}
+		if err := s.comments.DeleteComment(issue.ID, comment.ID, 1); !errors.Is(err, model.ErrCommentDeleted) {
+			t.Errorf("이미 삭제된 댓글은 다시 삭제할 수 없어야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestComment_권한_조회_권한만_있으면_쓸_수_없음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, s testServices) {
+		issue := createIssue(t, s)
+		comment, _ := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 2, Body: "역할이 바뀌기 전의 댓글"})
+		if _, err := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 3, Body: "보고자도 댓글을 달 수 있음"}); err != nil {
+			t.Errorf("보고자는 댓글을 달 수 있어야 함: %v", err)
+		}
+
+		viewer, _ := s.userRepo.GetByID(2)
+		viewer.Role = userModel.RoleViewer
+		if _, err := s.userRepo.Update(*viewer); err != nil {
+			t.Fatalf("역할 변경 실패: %v", err)
+		}
+
+		if _, err := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 2, Body: "본문"}); !errors.Is(err, ErrCommentReadOnlyRole) {
+			t.Errorf("viewer는 댓글을 달 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := s.comments.EditComment(issue.ID, comment.ID, EditCommentCommand{UserID: 2, Body: "고친 본문"}); !errors.Is(err, ErrCommentReadOnlyRole) {
+			t.Errorf("viewer는 자기 댓글도 고칠 수 없어야 함. 실제: %v", err)
+		}
+		if err := s.comments.DeleteComment(issue.ID, comment.ID, 2); !errors.Is(err, ErrCommentReadOnlyRole) {
+			t.Errorf("viewer는 자기 댓글도 지울 수 없어야 함. 실제: %v", err)
+		}
+		if saved, _ := s.commentRepo.GetByID(comment.ID); saved.Body != "역할이 바뀌기 전의 댓글" || saved.IsDeleted() {
+			t.Errorf("거부된 요청은 댓글을 바꾸지 않아야 함: %+v", saved)
+		}
+	})
+}
+
+func TestPurgeIssue_댓글이_있어도_완전_삭제(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, s testServices) {
+		issue := createIssue(t, s)
+		comment, _ := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 1, Body: "@이디자인님 확인 부탁드려요"})
+		s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 2, Body: "답글", ParentID: &comment.ID})
+		s.comments.EditComment(issue.ID, comment.ID, EditCommentCommand{UserID: 1, Body: "@이디자인님 다시 확인 부탁드려요"})
+
+		s.issues.DeleteIssue(issue.ID, nil)
+		if err := s.issues.PurgeIssue(issue.ID); err != nil {
+			t.Fatalf("댓글이 있는 이슈도 완전히 삭제할 수 있어야 함: %v", err)
+		}
+		if _, err := s.comments.GetComments(issue.ID); !errors.Is(err, issueApp.ErrIssueNotFound) {
+			t.Errorf("완전히 삭제된 이슈의 댓글은 조회할 수 없어야 함. 실제: %v", err)
+		}
+		if comments, _ := s.commentRepo.ListByIssue(issue.ID); len(comments) != 0 {
+			t.Errorf("완전히 삭제된 이슈의 댓글은 저장소에 남지 않아야 함: %+v", comments)
+		}
+		if mentions, _ := s.mentions.GetUserMentions(2); len(mentions) != 0 {
+			t.Errorf("완전히 삭제된 이슈의 멘션은 남지 않아야 함: %+v", mentions)
+		}
+	})
+}
+
+func TestComment_멘션_기록(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, s testServices) {
+		issue := createIssue(t, s)
+		comment, _ := s.comments.AddComment(issue.ID, AddCommentCommand{UserID: 1, Body: "@이디자인님 시안 확인 부탁드려요"})
+
+		mentions, _ := s.mentions.GetUserMentions(2)
+		if len(mentions) != 1 || mentions[0].CommentID == nil || *mentions[0].CommentID != comment.ID {
+			t.Fatalf("댓글의 멘션이 기록되어야 함: %+v", mentions)
+		}
+
+		s.comments.EditComment(issue.ID, comment.ID, EditCommentCommand{UserID: 1, Body: "@박기획님 확인 부탁드려요"})
+		if mentions, _ := s.mentions.GetUserMentions(2); len(mentions) != 0 {
+			t.Errorf("수정한 본문에 없는 멘션은 지워져야 함: %+v", mentions)
+		}
+		if mentions, _ := s.mentions.GetUserMentions(3); len(mentions) != 1 {
+			t.Errorf("수정한 본문의 멘션이 기록되어야 함: %+v", mentions)
+		}
+
+		s.comments.DeleteComment(issue.ID, comment.ID, 1)
+		if mentions, _ := s.mentions.GetUserMentions(3); len(mentions) != 0 {
+			t.Errorf("삭제된 댓글의 멘션은 지워져야 함: %+v", mentions)
+		}
+	})
+}
+
+func TestIssue_설명_멘션_기록(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, s testServices) {
+		issue, _ := s.issues.CreateIssue("로그인 오류", "@김개발 확인 부탁드립니다", nil)
+		if mentions, _ := s.mentions.GetUserMentions(1); len(mentions) != 1 || mentions[0].CommentID != nil {
+			t.Fatalf("이슈 설명의 멘션이 기록되어야 함: %+v", mentions)
+		}
+
+		s.issues.UpdateIssue(issue.ID, issueModel.NewUpdateCommand().WithDescription("@이디자인 확인 부탁드립니다"), nil)
+		if mentions, _ := s.mentions.GetUserMentions(1); len(mentions) != 0 {
+			t.Errorf("설명을 고치면 빠진 멘션은 지워져야 함: %+v", mentions)
+		}
+		if mentions, _ := s.mentions.GetUserMentions(2); len(mentions) != 1 {
+			t.Errorf("고친 설명의 멘션이 기록되어야 함: %+v", mentions)
+		}
+
+		s.issues.DeleteIssue(issue.ID, nil)
+		if err := s.issues.PurgeIssue(issue.ID); err != nil {
+			t.Fatalf("멘션이 있는 이슈도 완전히 삭제할 수 있어야 함: %v", err)
+		}
+		if mentions, _ := s.mentions.GetUserMentions(2); len(mentions) != 0 {
+			t.Errorf("완전히 삭제된 이슈의 멘션은 보이지 않아야 함: %+v", mentions)
+		}
+	})
+}
+
+// 멘션 저장소에 쓸 수 없는 상황을 흉내 낸다
+type failingMentions struct {
+	mentionApp.MentionService
+}
+
+func (failingMentions) RecordIssueMentions(issueModel.Issue) error {
+	return errors.New("멘션 저장소 오류")
+}
+
+func (failingMentions) RecordCommentMentions(model.Comment) error {
+	return errors.New("멘션 저장소 오류")
+}
+
+func TestMention_기록에_실패해도_저장된_이슈와_댓글은_성공으로_응답(t *testing.T) {
+	issueRepo, userRepo := issueInfra.NewIssueRepository(), userInfra.NewUserRepository()
+	commentRepo := commentInfra.NewCommentRepository()
+	issues := asAdmin(issueApp.NewIssueService(issueRepo, userRepo, commentRepo, failingMentions{}))
+	comments := NewCommentService(commentRepo, issueRepo, userRepo, failingMentions{})
+
+	issue, err := issues.CreateIssue("로그인 오류", "@김개발 확인 부탁드립니다", nil)
+	if err != nil {
+		t.Fatalf("이슈 생성은 성공해야 함: %v", err)
+	}
+	updated, err := issues.UpdateIssue(issue.ID, issueModel.NewUpdateCommand().WithDescription("@이디자인 확인 부탁드립니다"), nil)
+	if err != nil || updated.Version != issue.Version+1 {
+		t.Fatalf("이슈 수정은 성공해야 함: %+v, %v", updated, err)
+	}
+
+	comment, err := comments.AddComment(issue.ID, AddCommentCommand{UserID: 1, Body: "@이디자인님 시안 확인 부탁드려요"})
+	if err != nil {
+		t.Fatalf("댓글 작성은 성공해야 함: %v", err)
+	}
+	if _, err := comments.EditComment(issue.ID, comment.ID, EditCommentCommand{UserID: 1, Body: "@박기획님 확인 부탁드려요"}); err != nil {
+		t.Errorf("댓글 수정은 성공해야 함: %v", err)
+	}
+}
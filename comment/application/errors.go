@@ -0,0 +1,14 @@
+package application
+
+import "issue-service-aoroa/internal/apperr"
+
+var ErrCommentNotFound = &apperr.DomainError{
+	Code:    "COMMENT_NOT_FOUND",
+	Message: "댓글을 찾을 수 없습니다",
+}
+
+// 403 PERMISSION_DENIED이며, 이슈 API의 READ_ONLY_ROLE과 메시지가 다르므로 reason을 따로 둔다
+var ErrCommentReadOnlyRole = &apperr.PermissionError{
+	Reason:  "COMMENT_READ_ONLY_ROLE",
+	Message: "조회 권한만 있는 사용자는 댓글을 작성하거나 고칠 수 없습니다",
+}
@@ -0,0 +1,116 @@
+package infrastructure
+
+import (
+	"slices"
+	"sync"
+
+	commentModel "issue-service-aoroa/comment/model"
+)
+
+// 삭제된 댓글도 그대로 조회된다. ListByIssue는 작성 순서(ID 오름차순)로 반환한다.
+// Update는 본문, 삭제 시각과 함께 새로 추가된 수정 이력을 저장하며,
+// 저장된 Version이 comment.Version과 다르면 ErrCommentConflict를 반환한다.
+// DeleteByIssue는 이슈를 완전히 삭제할 때 그 이슈의 댓글과 수정 이력을 모두 지운다
+type CommentRepository interface {
+	Create(comment commentModel.Comment) (commentModel.Comment, error)
+	GetByID(id uint) (*commentModel.Comment, error)
+	ListByIssue(issueID uint) ([]commentModel.Comment, error)
+	Update(comment commentModel.Comment) (*commentModel.Comment, error)
+	DeleteByIssue(issueID uint) error
+}
+
+type commentRepository struct {
+	mu       sync.RWMutex
+	comments []commentModel.Comment
+	lastID   uint
+}
+
+func NewCommentRepository() CommentRepository {
+	return &commentRepository{comments: []commentModel.Comment{}}
+}
+
+func (r *commentRepository) Create(comment commentModel.Comment) (commentModel.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastID++
+	comment = cloneComment(comment)
+	comment.ID = r.lastID
+	comment.Version = 1
+	r.comments = append(r.comments, comment)
+	return cloneComment(comment), nil
+}
+
+func (r *commentRepository) GetByID(id uint) (*commentModel.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, comment := range r.comments {
+		if comment.ID == id {
+			found := cloneComment(comment)
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *commentRepository) ListByIssue(issueID uint) ([]commentModel.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	comments := []commentModel.Comment{}
+	for _, comment := range r.comments {
+		if comment.IssueID == issueID {
+			comments = append(comments, cloneComment(comment))
+		}
+	}
+	return comments, nil
+}
+
+func (r *commentRepository) Update(updated commentModel.Comment) (*commentModel.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, comment := range r.comments {
+		if comment.ID == updated.ID {
+			if updated.Version != comment.Version {
+				return nil, commentModel.ErrCommentConflict
+			}
+
+			// 작성 정보는 바뀌지 않는다
+			comment.Body = updated.Body
+			comment.Revisions = updated.Revisions
+			comment.UpdatedAt = updated.UpdatedAt
+			comment.DeletedAt = updated.DeletedAt
+			comment.Version++
+			r.comments[i] = cloneComment(comment)
+
+			result := cloneComment(comment)
+			return &result, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *commentRepository) DeleteByIssue(issueID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.comments = slices.DeleteFunc(r.comments, func(comment commentModel.Comment) bool {
+		return comment.IssueID == issueID
+	})
+	return nil
+}
+
+func cloneComment(comment commentModel.Comment) commentModel.Comment {
+	if comment.ParentID != nil {
+		parentID := *comment.ParentID
+		comment.ParentID = &parentID
+	}
+	if comment.DeletedAt != nil {
+		deletedAt := *comment.DeletedAt
+		comment.DeletedAt = &deletedAt
+	}
+	comment.Revisions = append([]commentModel.Revision(nil), comment.Revisions...)
+	return comment
+}
@@ -0,0 +1,221 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"time"
+
+	commentModel "issue-service-aoroa/comment/model"
+	"issue-service-aoroa/database"
+)
+
+const selectComments = `
+SELECT c.id, c.issue_id, c.parent_id, u.id, u.name, u.email, c.body, c.version, c.created_at, c.updated_at, c.deleted_at
+FROM comments c
+JOIN users u ON u.id = c.author_id`
+
+type sqliteCommentRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteCommentRepository(db *sql.DB) CommentRepository {
+	return &sqliteCommentRepository{db: db}
+}
+
+func (r *sqliteCommentRepository) Create(comment commentModel.Comment) (commentModel.Comment, error) {
+	result, err := r.db.Exec(
+		`INSERT INTO comments (issue_id, parent_id, author_id, body, version, created_at, updated_at) VALUES (?, ?, ?, ?, 1, ?, ?)`,
+		comment.IssueID, comment.ParentID, comment.Author.ID, comment.Body,
+		database.FormatTime(comment.CreatedAt), database.FormatTime(comment.UpdatedAt),
+	)
+	if err != nil {
+		return commentModel.Comment{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return commentModel.Comment{}, err
+	}
+	created, err := r.GetByID(uint(id))
+	if err != nil {
+		return commentModel.Comment{}, err
+	}
+	return *created, nil
+}
+
+func (r *sqliteCommentRepository) GetByID(id uint) (*commentModel.Comment, error) {
+	comments, err := r.query(selectComments+` WHERE c.id = ?`, id)
+	if err != nil || len(comments) == 0 {
+		return nil, err
+	}
+	return &comments[0], nil
+}
+
+func (r *sqliteCommentRepository) ListByIssue(issueID uint) ([]commentModel.Comment, error) {
+	return r.query(selectComments+` WHERE c.issue_id = ? ORDER BY c.id`, issueID)
+}
+
+// 저장된 수정 이력보다 뒤에 추가된 이력만 기록한다
+func (r *sqliteCommentRepository) Update(comment commentModel.Comment) (*commentModel.Comment, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var stored int
+	if err := tx.QueryRow(`SELECT COUNT(*) FROM comment_revisions WHERE comment_id = ?`, comment.ID).Scan(&stored); err != nil {
+		return nil, err
+	}
+
+	var deletedAt *string
+	if comment.DeletedAt != nil {
+		formatted := database.FormatTime(*comment.DeletedAt)
+		deletedAt = &formatted
+	}
+	result, err := tx.Exec(
+		`UPDATE comments SET body = ?, updated_at = ?, deleted_at = ?, version = version + 1 WHERE id = ? AND version = ?`,
+		comment.Body, database.FormatTime(comment.UpdatedAt), deletedAt, comment.ID, comment.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		var exists bool
+		if err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM comments WHERE id = ?)`, comment.ID).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, nil
+		}
+		return nil, commentModel.ErrCommentConflict
+	}
+
+	for _, revision := range comment.Revisions[min(stored, len(comment.Revisions)):] {
+		if _, err := tx.Exec(
+			`INSERT INTO comment_revisions (comment_id, body, created_at) VALUES (?, ?, ?)`,
+			comment.ID, revision.Body, database.FormatTime(revision.At),
+		); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(comment.ID)
+}
+
+func (r *sqliteCommentRepository) DeleteByIssue(issueID uint) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM comment_revisions WHERE comment_id IN (SELECT id FROM comments WHERE issue_id = ?)`, issueID); err != nil {
+		return err
+	}
+	// 답글이 부모 댓글을 참조하므로 답글부터 지운다
+	if _, err := tx.Exec(`DELETE FROM comments WHERE issue_id = ? AND parent_id IS NOT NULL`, issueID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM comments WHERE issue_id = ?`, issueID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 댓글을 모두 읽은 뒤 수정 이력을 따로 조회한다. 커넥션이 하나뿐이라 rows를 연 채로 다른 쿼리를 실행할 수 없다
+func (r *sqliteCommentRepository) query(query string, args ...any) ([]commentModel.Comment, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := []commentModel.Comment{}
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range comments {
+		if comments[i].Revisions, err = r.revisions(comments[i].ID); err != nil {
+			return nil, err
+		}
+	}
+	return comments, nil
+}
+
+func (r *sqliteCommentRepository) revisions(commentID uint) ([]commentModel.Revision, error) {
+	rows, err := r.db.Query(`SELECT body, created_at FROM comment_revisions WHERE comment_id = ? ORDER BY id`, commentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []commentModel.Revision
+	for rows.Next() {
+		var (
+			revision  commentModel.Revision
+			createdAt string
+		)
+		if err := rows.Scan(&revision.Body, &createdAt); err != nil {
+			return nil, err
+		}
+		if revision.At, err = database.ParseTime(createdAt); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, rows.Err()
+}
+
+func scanComment(rows *sql.Rows) (commentModel.Comment, error) {
+	var (
+		comment   commentModel.Comment
+		parentID  sql.NullInt64
+		createdAt string
+		updatedAt string
+		deletedAt sql.NullString
+		email     sql.NullString
+	)
+	if err := rows.Scan(&comment.ID, &comment.IssueID, &parentID, &comment.Author.ID, &comment.Author.Name, &email,
+		&comment.Body, &comment.Version, &createdAt, &updatedAt, &deletedAt); err != nil {
+		return commentModel.Comment{}, err
+	}
+
+	comment.Author.Email = email.String
+	if parentID.Valid {
+		id := uint(parentID.Int64)
+		comment.ParentID = &id
+	}
+	var err error
+	if comment.CreatedAt, err = database.ParseTime(createdAt); err != nil {
+		return commentModel.Comment{}, err
+	}
+	if comment.UpdatedAt, err = database.ParseTime(updatedAt); err != nil {
+		return commentModel.Comment{}, err
+	}
+	if deletedAt.Valid {
+		var at time.Time
+		if at, err = database.ParseTime(deletedAt.String); err != nil {
+			return commentModel.Comment{}, err
+		}
+		comment.DeletedAt = &at
+	}
+	return comment, nil
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"issue-service-aoroa/internal/apperr"
+	userModel "issue-service-aoroa/user/model"
+)
+
+const maxBodyLength = 10000
+
+// 이슈에 달린 댓글. 답글은 최상위 댓글에만 달 수 있어 스레드는 한 단계 깊이다.
+// 수정하거나 삭제하면 이전 본문을 Revisions에 남기고, Version은 수정할 때마다 1씩 증가한다
+type Comment struct {
+	ID        uint
+	IssueID   uint
+	ParentID  *uint
+	Author    userModel.User
+	Body      string
+	Revisions []Revision
+	Version   uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// 수정되기 전의 본문과 그 본문이 작성된 시각
+type Revision struct {
+	Body string    `json:"body"`
+	At   time.Time `json:"at"`
+}
+
+// parent가 있으면 답글이다. parent가 같은 이슈의 댓글인지는 호출하는 쪽에서 확인한다
+func NewComment(issueID uint, author userModel.User, body string, parent *Comment, now time.Time) (*Comment, error) {
+	body, err := validateBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &Comment{
+		IssueID:   issueID,
+		Author:    author,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if parent != nil {
+		if parent.IsReply() {
+			return nil, ErrReplyDepthExceeded
+		}
+		if parent.IsDeleted() {
+			return nil, ErrCommentDeleted
+		}
+		parentID := parent.ID
+		comment.ParentID = &parentID
+	}
+	return comment, nil
+}
+
+func (c *Comment) IsReply() bool {
+	return c.ParentID != nil
+}
+
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// 본문이 그대로이면 아무것도 바꾸지 않고 false를 반환한다
+func (c *Comment) Edit(userID uint, body string, now time.Time) (bool, error) {
+	if err := c.checkAuthor(userID); err != nil {
+		return false, err
+	}
+
+	body, err := validateBody(body)
+	if err != nil {
+		return false, err
+	}
+	if body == c.Body {
+		return false, nil
+	}
+
+	c.keepRevision()
+	c.Body = body
+	c.UpdatedAt = now
+	return true, nil
+}
+
+// 삭제한 댓글은 본문을 비우고 자리만 남겨, 달린 답글이 스레드에서 사라지지 않게 한다
+func (c *Comment) Delete(userID uint, now time.Time) error {
+	if err := c.checkAuthor(userID); err != nil {
+		return err
+	}
+
+	c.keepRevision()
+	c.Body = ""
+	c.UpdatedAt = now
+	c.DeletedAt = &now
+	return nil
+}
+
+func (c *Comment) checkAuthor(userID uint) error {
+	if c.IsDeleted() {
+		return ErrCommentDeleted
+	}
+	if c.Author.ID != userID {
+		return ErrNotCommentAuthor
+	}
+	return nil
+}
+
+func (c *Comment) keepRevision() {
+	c.Revisions = append(c.Revisions, Revision{Body: c.Body, At: c.UpdatedAt})
+}
+
+func validateBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", &apperr.ValidationError{Field: "body", Message: "댓글 본문은 필수입니다"}
+	}
+	if utf8.RuneCountInString(body) > maxBodyLength {
+		return "", &apperr.ValidationError{Field: "body", Reason: "BODY_TOO_LONG", Message: "댓글 본문은 10000자 이하여야 합니다"}
+	}
+	return body, nil
+}
+
+// 최상위 댓글과 그 답글. 둘 다 작성 순서대로 정렬된다
+type Thread struct {
+	Comment Comment
+	Replies []Comment
+}
+
+// comments는 작성 순서대로 정렬되어 있어야 한다
+func Threads(comments []Comment) []Thread {
+	threads := []Thread{}
+	position := map[uint]int{}
+	for _, comment := range comments {
+		if !comment.IsReply() {
+			position[comment.ID] = len(threads)
+			threads = append(threads, Thread{Comment: comment, Replies: []Comment{}})
+		}
+	}
+	for _, comment := range comments {
+		if comment.IsReply() {
+			if i, ok := position[*comment.ParentID]; ok {
+				threads[i].Replies = append(threads[i].Replies, comment)
+			}
+		}
+	}
+	return threads
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"issue-service-aoroa/internal/apperr"
+	userModel "issue-service-aoroa/user/model"
+)
+
+var author = userModel.User{ID: 1, Name: "김개발"}
+
+func TestNewComment_성공_답글(t *testing.T) {
+	parent, _ := NewComment(1, author, "  첫 댓글  ", nil, time.Now())
+	parent.ID = 10
+
+	reply, err := NewComment(1, author, "답글", parent, time.Now())
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if parent.Body != "첫 댓글" {
+		t.Errorf("본문 앞뒤 공백은 제거되어야 함. 실제: %q", parent.Body)
+	}
+	if !reply.IsReply() || *reply.ParentID != 10 {
+		t.Errorf("부모 댓글을 가리켜야 함. 실제: %v", reply.ParentID)
+	}
+}
+
+func TestNewComment_실패(t *testing.T) {
+	parent, _ := NewComment(1, author, "첫 댓글", nil, time.Now())
+	parent.ID = 10
+	reply, _ := NewComment(1, author, "답글", parent, time.Now())
+	deleted, _ := NewComment(1, author, "지울 댓글", nil, time.Now())
+	deleted.Delete(author.ID, time.Now())
+
+	var validationErr *apperr.ValidationError
+	if _, err := NewComment(1, author, "   ", nil, time.Now()); !errors.As(err, &validationErr) || validationErr.Field != "body" {
+		t.Errorf("빈 본문은 검증 에러여야 함. 실제: %v", err)
+	}
+	if _, err := NewComment(1, author, strings.Repeat("가", maxBodyLength+1), nil, time.Now()); !errors.As(err, &validationErr) || validationErr.Reason != "BODY_TOO_LONG" {
+		t.Errorf("너무 긴 본문은 검증 에러여야 함. 실제: %v", err)
+	}
+	if _, err := NewComment(1, author, "답글의 답글", reply, time.Now()); !errors.Is(err, ErrReplyDepthExceeded) {
+		t.Errorf("답글에는 답글을 달 수 없어야 함. 실제: %v", err)
+	}
+	if _, err := NewComment(1, author, "답글", deleted, time.Now()); !errors.Is(err, ErrCommentDeleted) {
+		t.Errorf("삭제된 댓글에는 답글을 달 수 없어야 함. 실제: %v", err)
+	}
+}
+
+func TestComment_Edit_이전_본문을_남김(t *testing.T) {
+	createdAt := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	comment, _ := NewComment(1, author, "처음 본문", nil, createdAt)
+
+	changed, err := comment.Edit(author.ID, "고친 본문", createdAt.Add(time.Hour))
+	if err != nil || !changed {
+		t.Fatalf("수정되어야 함: %v", err)
+	}
+	if comment.Body != "고친 본문" || len(comment.Revisions) != 1 {
+		t.Fatalf("본문과 이력이 바뀌어야 함: %+v", comment)
+	}
+	if comment.Revisions[0].Body != "처음 본문" || !comment.Revisions[0].At.Equal(createdAt) {
+		t.Errorf("이전 본문과 작성 시각이 남아야 함: %+v", comment.Revisions[0])
+	}
+
+	if changed, _ := comment.Edit(author.ID, "고친 본문", time.Now()); changed || len(comment.Revisions) != 1 {
+		t.Error("같은 본문으로 수정하면 아무것도 바뀌지 않아야 함")
+	}
+}
+
+func TestComment_실패_작성자가_아님(t *testing.T) {
+	comment, _ := NewComment(1, author, "본문", nil, time.Now())
+
+	if _, err := comment.Edit(2, "남의 댓글", time.Now()); !errors.Is(err, ErrNotCommentAuthor) {
+		t.Errorf("작성자가 아니면 수정할 수 없어야 함. 실제: %v", err)
+	}
+	if err := comment.Delete(2, time.Now()); !errors.Is(err, ErrNotCommentAuthor) {
+		t.Errorf("작성자가 아니면 삭제할 수 없어야 함. 실제: %v", err)
+	}
+}
+
+func TestComment_Delete(t *testing.T) {
+	comment, _ := NewComment(1, author, "본문", nil, time.Now())
+
+	if err := comment.Delete(author.ID, time.Now()); err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if !comment.IsDeleted() || comment.Body != "" || comment.Revisions[0].Body != "본문" {
+		t.Errorf("본문을 비우고 이력에 남겨야 함: %+v", comment)
+	}
+	if _, err := comment.Edit(author.ID, "되살리기", time.Now()); !errors.Is(err, ErrCommentDeleted) {
+		t.Errorf("삭제된 댓글은 수정할 수 없어야 함. 실제: %v", err)
+	}
+}
+
+func TestThreads(t *testing.T) {
+	first, second := uint(1), uint(2)
+	comments := []Comment{
+		{ID: 1, Body: "첫 댓글"},
+		{ID: 2, Body: "둘째 댓글"},
+		{ID: 3, Body: "둘째의 답글", ParentID: &second},
+		{ID: 4, Body: "첫째의 답글", ParentID: &first},
+	}
+
+	threads := Threads(comments)
+	if len(threads) != 2 {
+		t.Fatalf("최상위 댓글 2개여야 함. 실제: %d", len(threads))
+	}
+	if len(threads[0].Replies) != 1 || threads[0].Replies[0].ID != 4 || threads[1].Replies[0].ID != 3 {
+		t.Errorf("답글이 부모 댓글 아래에 모여야 함: %+v", threads)
+	}
+}
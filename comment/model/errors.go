@@ -0,0 +1,23 @@
+package model
+
+import "issue-service-aoroa/internal/apperr"
+
+// 댓글 에러도 다른 API와 같은 에러 응답 형식을 쓰도록 공통 DomainError로 정의한다
+var (
+	ErrNotCommentAuthor = &apperr.DomainError{
+		Code:    "COMMENT_AUTHOR_REQUIRED",
+		Message: "작성자만 댓글을 수정하거나 삭제할 수 있습니다",
+	}
+	ErrCommentDeleted = &apperr.DomainError{
+		Code:    "COMMENT_DELETED",
+		Message: "삭제된 댓글입니다",
+	}
+	ErrCommentConflict = &apperr.DomainError{
+		Code:    "COMMENT_CONFLICT",
+		Message: "댓글이 이미 다른 요청에 의해 수정되었습니다. 다시 시도하세요",
+	}
+	ErrReplyDepthExceeded = &apperr.DomainError{
+		Code:    "REPLY_DEPTH_EXCEEDED",
+		Message: "답글에는 답글을 달 수 없습니다",
+	}
+)
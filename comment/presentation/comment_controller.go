@@ -0,0 +1,224 @@
+package presentation
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"issue-service-aoroa/comment/application"
+	"issue-service-aoroa/comment/model"
+	"issue-service-aoroa/internal/httpx"
+	userApp "issue-service-aoroa/user/application"
+	userModel "issue-service-aoroa/user/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 에러 응답은 httpx.ErrorHandler가 만든다
+type CommentController struct {
+	commentService application.CommentService
+}
+
+func NewCommentController(commentService application.CommentService) *CommentController {
+	return &CommentController{commentService: commentService}
+}
+
+// 작성자와 수정·삭제하는 사용자는 본문이 아니라 인증된 사용자로 정한다
+type AddCommentRequest struct {
+	Body     string `json:"body"`
+	ParentID *uint  `json:"parentId"`
+}
+
+type EditCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// 삭제된 댓글은 본문 없이 deletedAt만 채워 자리를 남긴다
+type CommentResponse struct {
+	ID            uint           `json:"id"`
+	IssueID       uint           `json:"issueId"`
+	ParentID      *uint          `json:"parentId,omitempty"`
+	Author        userModel.User `json:"author"`
+	Body          string         `json:"body"`
+	RevisionCount int            `json:"revisionCount"`
+	Version       uint           `json:"version"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+	DeletedAt     *time.Time     `json:"deletedAt,omitempty"`
+}
+
+type ThreadResponse struct {
+	CommentResponse
+	Replies []CommentResponse `json:"replies"`
+}
+
+func (c *CommentController) AddComment(ctx *gin.Context) {
+	issueID, err := parseUintParam(ctx, "id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	user, err := authenticatedUser(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var req AddCommentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(httpx.ErrInvalidRequest)
+		return
+	}
+
+	comment, err := c.commentService.AddComment(issueID, application.AddCommentCommand{
+		UserID:   user.ID,
+		Body:     req.Body,
+		ParentID: req.ParentID,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toCommentResponse(*comment))
+}
+
+func (c *CommentController) GetComments(ctx *gin.Context) {
+	issueID, err := parseUintParam(ctx, "id")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	threads, err := c.commentService.GetComments(issueID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	response := make([]ThreadResponse, 0, len(threads))
+	for _, thread := range threads {
+		replies := make([]CommentResponse, 0, len(thread.Replies))
+		for _, reply := range thread.Replies {
+			replies = append(replies, toCommentResponse(reply))
+		}
+		response = append(response, ThreadResponse{
+			CommentResponse: toCommentResponse(thread.Comment),
+			Replies:         replies,
+		})
+	}
+	ctx.JSON(http.StatusOK, gin.H{"comments": response})
+}
+
+func (c *CommentController) EditComment(ctx *gin.Context) {
+	issueID, commentID, err := parseCommentPath(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	user, err := authenticatedUser(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var req EditCommentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(httpx.ErrInvalidRequest)
+		return
+	}
+
+	comment, err := c.commentService.EditComment(issueID, commentID, application.EditCommentCommand{
+		UserID: user.ID,
+		Body:   req.Body,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toCommentResponse(*comment))
+}
+
+func (c *CommentController) DeleteComment(ctx *gin.Context) {
+	issueID, commentID, err := parseCommentPath(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	user, err := authenticatedUser(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if err := c.commentService.DeleteComment(issueID, commentID, user.ID); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *CommentController) GetCommentRevisions(ctx *gin.Context) {
+	issueID, commentID, err := parseCommentPath(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	revisions, err := c.commentService.GetCommentRevisions(issueID, commentID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"revisions": revisions})
+}
+
+func toCommentResponse(comment model.Comment) CommentResponse {
+	return CommentResponse{
+		ID:            comment.ID,
+		IssueID:       comment.IssueID,
+		ParentID:      comment.ParentID,
+		Author:        comment.Author,
+		Body:          comment.Body,
+		RevisionCount: len(comment.Revisions),
+		Version:       comment.Version,
+		CreatedAt:     comment.CreatedAt,
+		UpdatedAt:     comment.UpdatedAt,
+		DeletedAt:     comment.DeletedAt,
+	}
+}
+
+// 댓글을 쓰는 요청은 httpx.Authenticate를 거쳐야 한다
+func authenticatedUser(ctx *gin.Context) (*userModel.User, error) {
+	user := httpx.AuthenticatedUser(ctx)
+	if user == nil {
+		return nil, userApp.ErrAuthenticationRequired
+	}
+	return user, nil
+}
+
+func parseCommentPath(ctx *gin.Context) (uint, uint, error) {
+	issueID, err := parseUintParam(ctx, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+	commentID, err := parseUintParam(ctx, "commentId")
+	if err != nil {
+		return 0, 0, err
+	}
+	return issueID, commentID, nil
+}
+
+func parseUintParam(ctx *gin.Context, name string) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Param(name), 10, 32)
+	if err != nil {
+		return 0, httpx.ErrInvalidID
+	}
+	return uint(id), nil
+}
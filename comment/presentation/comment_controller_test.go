@@ -0,0 +1,169 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"issue-service-aoroa/comment/application"
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/internal/httpx"
+	issueApp "issue-service-aoroa/issue/application"
+	issueInfra "issue-service-aoroa/issue/infrastructure"
+	issuePresentation "issue-service-aoroa/issue/presentation"
+	mentionApp "issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	userApp "issue-service-aoroa/user/application"
+	userInfra "issue-service-aoroa/user/infrastructure"
+
+	"github.com/gin-gonic/gin"
+)
+
+type testServer struct {
+	router *gin.Engine
+	issues issueApp.IssueService
+}
+
+// 사용자 n은 API 키 "key-n"으로 인증한다
+func setupTestServer() *testServer {
+	gin.SetMode(gin.TestMode)
+
+	issueRepo, userRepo, commentRepo := issueInfra.NewIssueRepository(), userInfra.NewUserRepository(), commentInfra.NewCommentRepository()
+	mentions := mentionApp.NewMentionService(mentionInfra.NewMentionRepository(), issueRepo, userRepo)
+	controller := NewCommentController(application.NewCommentService(commentRepo, issueRepo, userRepo, mentions))
+	authenticator := userApp.NewAuthenticator(userRepo, userApp.AuthConfig{
+		APIKeys: map[string]uint{"key-1": 1, "key-2": 2, "key-3": 3},
+	})
+
+	router := gin.New()
+	router.Use(httpx.ErrorHandler(ErrorMapping, issuePresentation.ErrorMapping))
+	router.Use(httpx.Authenticate(authenticator))
+	router.POST("/issue/:id/comments", controller.AddComment)
+	router.GET("/issue/:id/comments", controller.GetComments)
+	router.PATCH("/issue/:id/comments/:commentId", controller.EditComment)
+	router.DELETE("/issue/:id/comments/:commentId", controller.DeleteComment)
+	router.GET("/issue/:id/comments/:commentId/revisions", controller.GetCommentRevisions)
+
+	admin, _ := userRepo.GetByID(1)
+	issues := issueApp.NewIssueService(issueRepo, userRepo, commentRepo, mentions).WithAudit(issueApp.AuditContext{Actor: admin})
+	return &testServer{router: router, issues: issues}
+}
+
+func (s *testServer) do(method, path, body string) *httptest.ResponseRecorder {
+	return s.doAs(1, method, path, body)
+}
+
+// userID가 0이면 인증 없이 요청한다
+func (s *testServer) doAs(userID uint, method, path, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if userID != 0 {
+		request.Header.Set(httpx.APIKeyHeader, fmt.Sprintf("key-%d", userID))
+	}
+	recorder := httptest.NewRecorder()
+	s.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (s *testServer) addComment(t *testing.T, userID, issueID uint, body string) CommentResponse {
+	t.Helper()
+	recorder := s.doAs(userID, http.MethodPost, fmt.Sprintf("/issue/%d/comments", issueID), body)
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("201이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var comment CommentResponse
+	json.Unmarshal(recorder.Body.Bytes(), &comment)
+	return comment
+}
+
+func TestCommentAPI_작성_답글_조회(t *testing.T) {
+	server := setupTestServer()
+	issue, _ := server.issues.CreateIssue("로그인 오류", "설명", nil)
+	server.issues.TransitionIssue(issue.ID, issueApp.TransitionIssueCommand{Name: "cancel"}, nil)
+
+	comment := server.addComment(t, 1, issue.ID, `{"body": "취소된 이슈에도 댓글"}`)
+	server.addComment(t, 2, issue.ID, fmt.Sprintf(`{"body": "답글", "parentId": %d}`, comment.ID))
+
+	recorder := server.do(http.MethodGet, fmt.Sprintf("/issue/%d/comments", issue.ID), "")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d", recorder.Code)
+	}
+	var response struct {
+		Comments []ThreadResponse `json:"comments"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	if len(response.Comments) != 1 || len(response.Comments[0].Replies) != 1 || response.Comments[0].Author.Name != "김개발" {
+		t.Fatalf("댓글 스레드가 반환되어야 함: %s", recorder.Body.String())
+	}
+	if author := response.Comments[0].Replies[0].Author; author.Name != "이디자인" {
+		t.Errorf("답글 작성자는 인증된 사용자여야 함. 실제: %s", author.Name)
+	}
+}
+
+func TestCommentAPI_수정과_삭제(t *testing.T) {
+	server := setupTestServer()
+	issue, _ := server.issues.CreateIssue("로그인 오류", "설명", nil)
+	comment := server.addComment(t, 1, issue.ID, `{"body": "처음"}`)
+	path := fmt.Sprintf("/issue/%d/comments/%d", issue.ID, comment.ID)
+
+	recorder := server.doAs(2, http.MethodPatch, path, `{"body": "남의 댓글"}`)
+	if recorder.Code != http.StatusForbidden || !strings.Contains(recorder.Body.String(), "COMMENT_AUTHOR_REQUIRED") {
+		t.Errorf("작성자가 아니면 403이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = server.doAs(1, http.MethodPatch, path, `{"body": "고친 본문"}`)
+	var edited CommentResponse
+	json.Unmarshal(recorder.Body.Bytes(), &edited)
+	if recorder.Code != http.StatusOK || edited.Body != "고친 본문" || edited.RevisionCount != 1 {
+		t.Errorf("수정되어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = server.do(http.MethodGet, path+"/revisions", "")
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"body":"처음"`) {
+		t.Errorf("수정 이력이 반환되어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	if recorder = server.doAs(1, http.MethodDelete, path, ""); recorder.Code != http.StatusNoContent {
+		t.Errorf("204여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	if recorder = server.doAs(1, http.MethodPatch, path, `{"body": "되살리기"}`); recorder.Code != http.StatusConflict {
+		t.Errorf("삭제된 댓글 수정은 409여야 함. 실제: %d", recorder.Code)
+	}
+}
+
+func TestCommentAPI_실패(t *testing.T) {
+	server := setupTestServer()
+	issue, _ := server.issues.CreateIssue("로그인 오류", "설명", nil)
+	comment := server.addComment(t, 1, issue.ID, `{"body": "댓글"}`)
+	reply := server.addComment(t, 1, issue.ID, fmt.Sprintf(`{"body": "답글", "parentId": %d}`, comment.ID))
+	commentsPath := fmt.Sprintf("/issue/%d/comments", issue.ID)
+
+	tests := []struct {
+		name      string
+		userID    uint
+		method    string
+		path      string
+		body      string
+		status    int
+		errorCode string
+	}{
+		{"인증 없음", 0, http.MethodPost, commentsPath, `{"body": "본문"}`, http.StatusUnauthorized, "AUTHENTICATION_REQUIRED"},
+		{"본문의 userId는 무시", 1, http.MethodPost, commentsPath, `{"userId": 999, "body": " "}`, http.StatusBadRequest, "VALIDATION_FAILED"},
+		{"답글의 답글", 1, http.MethodPost, commentsPath, fmt.Sprintf(`{"body": "본문", "parentId": %d}`, reply.ID), http.StatusBadRequest, "REPLY_DEPTH_EXCEEDED"},
+		{"없는 이슈", 1, http.MethodGet, "/issue/999/comments", "", http.StatusNotFound, "ISSUE_NOT_FOUND"},
+		{"없는 댓글", 1, http.MethodPatch, commentsPath + "/999", `{"body": "본문"}`, http.StatusNotFound, "COMMENT_NOT_FOUND"},
+		{"잘못된 댓글 ID", 1, http.MethodGet, commentsPath + "/abc/revisions", "", http.StatusBadRequest, "INVALID_ID"},
+	}
+
+	for _, tt := range tests {
+		recorder := server.doAs(tt.userID, tt.method, tt.path, tt.body)
+		if recorder.Code != tt.status || !strings.Contains(recorder.Body.String(), tt.errorCode) {
+			t.Errorf("%s: %d %s이어야 함. 실제: %d, %s", tt.name, tt.status, tt.errorCode, recorder.Code, recorder.Body.String())
+		}
+	}
+}
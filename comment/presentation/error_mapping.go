@@ -0,0 +1,68 @@
+package presentation
+
+import (
+	"errors"
+	"net/http"
+
+	"issue-service-aoroa/comment/application"
+	"issue-service-aoroa/comment/model"
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/httpx"
+)
+
+// 댓글 API의 에러를 HTTP 상태 코드와 에러 응답으로 바꾸는 규칙. httpx.ErrorHandler에 넘겨 쓴다
+var ErrorMapping = httpx.ErrorMapping{
+	Status:   statusOf,
+	Messages: errorMessages,
+}
+
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, application.ErrCommentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrNotCommentAuthor):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrCommentDeleted),
+		errors.Is(err, model.ErrCommentConflict):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrReplyDepthExceeded):
+		return http.StatusBadRequest
+	default:
+		return 0
+	}
+}
+
+var errorMessages = i18n.Catalog{
+	"VALIDATION_FAILED.body": {
+		i18n.Korean:  "댓글 본문은 필수입니다",
+		i18n.English: "A comment body is required",
+	},
+	"VALIDATION_FAILED.BODY_TOO_LONG": {
+		i18n.Korean:  "댓글 본문은 10000자 이하여야 합니다",
+		i18n.English: "A comment body must be at most 10000 characters",
+	},
+	"COMMENT_NOT_FOUND": {
+		i18n.Korean:  "댓글을 찾을 수 없습니다",
+		i18n.English: "Comment not found",
+	},
+	"COMMENT_DELETED": {
+		i18n.Korean:  "삭제된 댓글입니다",
+		i18n.English: "The comment has been deleted",
+	},
+	"COMMENT_AUTHOR_REQUIRED": {
+		i18n.Korean:  "작성자만 댓글을 수정하거나 삭제할 수 있습니다",
+		i18n.English: "Only the author can edit or delete the comment",
+	},
+	"COMMENT_CONFLICT": {
+		i18n.Korean:  "댓글이 이미 다른 요청에 의해 수정되었습니다. 다시 시도하세요",
+		i18n.English: "The comment was modified by another request. Please try again",
+	},
+	"REPLY_DEPTH_EXCEEDED": {
+		i18n.Korean:  "답글에는 답글을 달 수 없습니다",
+		i18n.English: "Replies cannot have replies",
+	},
+	"PERMISSION_DENIED.COMMENT_READ_ONLY_ROLE": {
+		i18n.Korean:  "조회 권한만 있는 사용자는 댓글을 작성하거나 고칠 수 없습니다",
+		i18n.English: "Viewers cannot write or modify comments",
+	},
+}
@@ -0,0 +1,45 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	"issue-service-aoroa/comment/application"
+	"issue-service-aoroa/comment/model"
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/httpx"
+)
+
+func TestErrorMapping_댓글_에러_상태코드_매핑(t *testing.T) {
+	tests := []struct {
+		err       error
+		status    int
+		errorCode string
+	}{
+		{application.ErrCommentNotFound, http.StatusNotFound, "COMMENT_NOT_FOUND"},
+		{model.ErrNotCommentAuthor, http.StatusForbidden, "COMMENT_AUTHOR_REQUIRED"},
+		{model.ErrCommentDeleted, http.StatusConflict, "COMMENT_DELETED"},
+		{model.ErrCommentConflict, http.StatusConflict, "COMMENT_CONFLICT"},
+		{model.ErrReplyDepthExceeded, http.StatusBadRequest, "REPLY_DEPTH_EXCEEDED"},
+		{application.ErrCommentReadOnlyRole, http.StatusForbidden, "PERMISSION_DENIED"},
+	}
+
+	for _, tt := range tests {
+		response := httpx.NewErrorResponse(tt.err, i18n.English, ErrorMapping)
+
+		if response.Code != tt.status || response.ErrorCode != tt.errorCode {
+			t.Errorf("%v: %d %s이어야 함. 실제: %d %s", tt.err, tt.status, tt.errorCode, response.Code, response.ErrorCode)
+		}
+	}
+}
+
+func TestErrorMapping_모든_댓글_에러_코드에_영어_메시지_존재(t *testing.T) {
+	for code, messages := range errorMessages {
+		if _, ok := messages[i18n.English]; !ok {
+			t.Errorf("%s에 영어 메시지가 없음", code)
+		}
+		if _, ok := messages[i18n.Korean]; !ok {
+			t.Errorf("%s에 한국어 메시지가 없음", code)
+		}
+	}
+}
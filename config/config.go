@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	StorageMemory = "memory"
+	StorageSQLite = "sqlite"
+
+	// 이슈는 이벤트 로그 파일에 기록하고 시작할 때 재생해 복원한다. 사용자·댓글·멘션은 SQLitePath 파일에 둔다
+	StorageEventLog = "eventlog"
+)
+
+type Config struct {
+	Port       string
+	Storage    string
+	SQLitePath string
+
+	// eventlog 저장소가 쓰는 추가 전용 로그 파일 경로
+	EventLogPath string
+
+	// 이슈 워크플로 YAML 파일 경로. 비어 있으면 내장된 기본 워크플로를 쓴다.
+	WorkflowPath string
+
+	// Bearer 토큰(HS256 JWT)의 서명 키. 비어 있으면 JWT로 인증할 수 없다.
+	JWTSecret string
+
+	// "사용자ID:키" 쌍을 쉼표로 구분한 API 키 목록. 비어 있으면 API 키로 인증할 수 없다.
+	APIKeys string
+}
+
+func Load() Config {
+	return Config{
+		Port:         getEnv("PORT", "8080"),
+		Storage:      getEnv("ISSUE_STORAGE", StorageMemory),
+		SQLitePath:   getEnv("ISSUE_SQLITE_PATH", "issue-service.db"),
+		EventLogPath: getEnv("ISSUE_EVENT_LOG_PATH", "issue-events.log"),
+		WorkflowPath: os.Getenv("ISSUE_WORKFLOW_PATH"),
+		JWTSecret:    os.Getenv("ISSUE_JWT_SECRET"),
+		APIKeys:      os.Getenv("ISSUE_API_KEYS"),
+	}
+}
+
+// APIKeys를 키별 사용자 ID로 바꾼다. 키에는 ':'가 들어갈 수 있으므로 첫 ':'에서만 나눈다
+func (c Config) APIKeyUsers() (map[string]uint, error) {
+	users := map[string]uint{}
+	for _, entry := range strings.Split(c.APIKeys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, key, ok := strings.Cut(entry, ":")
+		userID, err := strconv.ParseUint(id, 10, 32)
+		if !ok || err != nil || key == "" {
+			return nil, fmt.Errorf("ISSUE_API_KEYS 항목은 '사용자ID:키' 형식이어야 합니다: %q", id)
+		}
+		if _, exists := users[key]; exists {
+			return nil, fmt.Errorf("ISSUE_API_KEYS에 같은 키가 여러 번 있습니다 (사용자 %d)", userID)
+		}
+		users[key] = uint(userID)
+	}
+	return users, nil
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,110 @@
+package migrations
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed sql/*.sql
+var sqlFiles embed.FS
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// up/down 스크립트 중 하나라도 바뀌면 체크섬이 달라진다
+func (m Migration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.Up + "\x00" + m.Down))
+	return hex.EncodeToString(sum[:])
+}
+
+func Load() ([]Migration, error) {
+	return loadFrom(sqlFiles, "sql")
+}
+
+// 파일 이름 형식: {버전}_{이름}.{up|down}.sql
+func loadFrom(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		version, name, direction, err := parseFileName(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("마이그레이션 %d의 이름이 일치하지 않습니다: %s, %s", version, migration.Name, name)
+		}
+
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("마이그레이션 %d_%s에 up 또는 down 스크립트가 없습니다", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+func parseFileName(fileName string) (int, string, string, error) {
+	base := strings.TrimSuffix(fileName, ".sql")
+
+	dot := strings.LastIndex(base, ".")
+	if dot < 0 {
+		return 0, "", "", fmt.Errorf("잘못된 마이그레이션 파일 이름입니다: %s", fileName)
+	}
+	direction := base[dot+1:]
+	if direction != "up" && direction != "down" {
+		return 0, "", "", fmt.Errorf("잘못된 마이그레이션 방향입니다: %s", fileName)
+	}
+
+	versionPart, name, ok := strings.Cut(base[:dot], "_")
+	if !ok || name == "" {
+		return 0, "", "", fmt.Errorf("잘못된 마이그레이션 파일 이름입니다: %s", fileName)
+	}
+	version, err := strconv.Atoi(versionPart)
+	if err != nil || version <= 0 {
+		return 0, "", "", fmt.Errorf("잘못된 마이그레이션 버전입니다: %s", fileName)
+	}
+
+	return version, name, direction, nil
+}
@@ -0,0 +1,183 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"issue-service-aoroa/database"
+)
+
+const schemaMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	name       TEXT    NOT NULL,
+	checksum   TEXT    NOT NULL,
+	applied_at TEXT    NOT NULL
+)`
+
+var ErrChecksumMismatch = errors.New("적용된 마이그레이션의 체크섬이 일치하지 않습니다")
+
+type Status struct {
+	Migration Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+func (m *Migrator) Up() ([]Migration, error) {
+	pending, err := m.Pending()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range pending {
+		if err := m.apply(migration); err != nil {
+			return nil, fmt.Errorf("마이그레이션 %d_%s 적용 실패: %w", migration.Version, migration.Name, err)
+		}
+	}
+	return pending, nil
+}
+
+func (m *Migrator) Down() (*Migration, error) {
+	applied, err := m.verifiedApplied()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if err := m.revert(migration); err != nil {
+			return nil, fmt.Errorf("마이그레이션 %d_%s 되돌리기 실패: %w", migration.Version, migration.Name, err)
+		}
+		return &migration, nil
+	}
+	return nil, nil
+}
+
+func (m *Migrator) Pending() ([]Migration, error) {
+	applied, err := m.verifiedApplied()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+func (m *Migrator) Status() ([]Status, error) {
+	applied, err := m.verifiedApplied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		record, ok := applied[migration.Version]
+		statuses = append(statuses, Status{
+			Migration: migration,
+			Applied:   ok,
+			AppliedAt: record.appliedAt,
+		})
+	}
+	return statuses, nil
+}
+
+type appliedRecord struct {
+	checksum  string
+	appliedAt time.Time
+}
+
+func (m *Migrator) verifiedApplied() (map[int]appliedRecord, error) {
+	if _, err := m.db.Exec(schemaMigrationsTable); err != nil {
+		return nil, err
+	}
+
+	rows, err := m.db.Query(`SELECT version, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]appliedRecord)
+	for rows.Next() {
+		var (
+			version   int
+			record    appliedRecord
+			appliedAt string
+		)
+		if err := rows.Scan(&version, &record.checksum, &appliedAt); err != nil {
+			return nil, err
+		}
+		if record.appliedAt, err = database.ParseTime(appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = record
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, migration := range m.migrations {
+		record, ok := applied[migration.Version]
+		if ok && record.checksum != migration.Checksum() {
+			return nil, fmt.Errorf("%w: %d_%s", ErrChecksumMismatch, migration.Version, migration.Name)
+		}
+	}
+	return applied, nil
+}
+
+func (m *Migrator) apply(migration Migration) error {
+	return m.inTransaction(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(migration.Up); err != nil {
+			return err
+		}
+		_, err := tx.Exec(
+			`INSERT INTO schema_migrations (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)`,
+			migration.Version, migration.Name, migration.Checksum(), database.FormatTime(time.Now()),
+		)
+		return err
+	})
+}
+
+func (m *Migrator) revert(migration Migration) error {
+	return m.inTransaction(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(migration.Down); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`DELETE FROM schema_migrations WHERE version = ?`, migration.Version)
+		return err
+	})
+}
+
+func (m *Migrator) inTransaction(fn func(tx *sql.Tx) error) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
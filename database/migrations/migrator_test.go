@@ -0,0 +1,104 @@
+package migrations
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"issue-service-aoroa/database"
+)
+
+func setupTestMigrator(t *testing.T, migrations []Migration) *Migrator {
+	db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("SQLite 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return NewMigrator(db, migrations)
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Name: "create_a", Up: "CREATE TABLE a (id INTEGER)", Down: "DROP TABLE a"},
+		{Version: 2, Name: "create_b", Up: "CREATE TABLE b (id INTEGER)", Down: "DROP TABLE b"},
+	}
+}
+
+func TestLoad_성공_내장_마이그레이션_버전순_정렬(t *testing.T) {
+	migrations, err := Load()
+
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i-1].Version >= migrations[i].Version {
+			t.Errorf("마이그레이션은 버전 오름차순이어야 함: %d, %d", migrations[i-1].Version, migrations[i].Version)
+		}
+	}
+}
+
+func TestLoad_실패_down_스크립트_누락(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/0001_create_a.up.sql": {Data: []byte("CREATE TABLE a (id INTEGER)")},
+	}
+
+	_, err := loadFrom(fsys, "sql")
+
+	if err == nil {
+		t.Error("down 스크립트가 없으면 에러가 발생해야 함")
+	}
+}
+
+func TestUp_성공_대기중인_마이그레이션_모두_적용(t *testing.T) {
+	migrator := setupTestMigrator(t, testMigrations())
+
+	applied, err := migrator.Up()
+
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if len(applied) != 2 {
+		t.Errorf("2개의 마이그레이션이 적용되어야 함. 실제: %d", len(applied))
+	}
+
+	pending, _ := migrator.Pending()
+	if len(pending) != 0 {
+		t.Errorf("대기중인 마이그레이션이 없어야 함. 실제: %d", len(pending))
+	}
+}
+
+func TestDown_성공_마지막_마이그레이션만_되돌림(t *testing.T) {
+	migrator := setupTestMigrator(t, testMigrations())
+	migrator.Up()
+
+	reverted, err := migrator.Down()
+
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if reverted == nil || reverted.Version != 2 {
+		t.Fatalf("버전 2 마이그레이션이 되돌려져야 함. 실제: %v", reverted)
+	}
+
+	statuses, _ := migrator.Status()
+	if !statuses[0].Applied || statuses[1].Applied {
+		t.Error("버전 1만 적용된 상태여야 함")
+	}
+}
+
+func TestStatus_실패_적용된_마이그레이션_체크섬_변경(t *testing.T) {
+	migrator := setupTestMigrator(t, testMigrations())
+	migrator.Up()
+
+	changed := testMigrations()
+	changed[0].Up = "CREATE TABLE a (id INTEGER, name TEXT)"
+	migrator.migrations = changed
+
+	_, err := migrator.Status()
+
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("체크섬 불일치 에러가 발생해야 함. 실제: %v", err)
+	}
+}
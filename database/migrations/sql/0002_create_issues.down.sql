@@ -0,0 +1,2 @@
+DROP INDEX idx_issues_status;
+DROP TABLE issues;
@@ -0,0 +1,11 @@
+CREATE TABLE issues (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	title       TEXT    NOT NULL,
+	description TEXT    NOT NULL DEFAULT '',
+	status      TEXT    NOT NULL,
+	user_id     INTEGER REFERENCES users(id),
+	created_at  TEXT    NOT NULL,
+	updated_at  TEXT    NOT NULL
+);
+
+CREATE INDEX idx_issues_status ON issues(status);
@@ -0,0 +1 @@
+ALTER TABLE issues ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,3 @@
+DROP INDEX idx_issues_title;
+DROP INDEX idx_issues_updated_at;
+DROP INDEX idx_issues_created_at;
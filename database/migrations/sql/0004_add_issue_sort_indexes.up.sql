@@ -0,0 +1,3 @@
+CREATE INDEX idx_issues_created_at ON issues(created_at, id);
+CREATE INDEX idx_issues_updated_at ON issues(updated_at, id);
+CREATE INDEX idx_issues_title ON issues(title, id);
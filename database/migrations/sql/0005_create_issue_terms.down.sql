@@ -0,0 +1,2 @@
+DROP INDEX idx_issue_terms_issue_id;
+DROP TABLE issue_terms;
@@ -0,0 +1,9 @@
+CREATE TABLE issue_terms (
+	term      TEXT    NOT NULL,
+	issue_id  INTEGER NOT NULL REFERENCES issues(id),
+	field     TEXT    NOT NULL,
+	frequency INTEGER NOT NULL,
+	PRIMARY KEY (term, issue_id, field)
+);
+
+CREATE INDEX idx_issue_terms_issue_id ON issue_terms(issue_id);
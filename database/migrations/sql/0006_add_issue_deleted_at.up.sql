@@ -0,0 +1 @@
+ALTER TABLE issues ADD COLUMN deleted_at TEXT;
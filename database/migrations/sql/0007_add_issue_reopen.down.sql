@@ -0,0 +1,4 @@
+ALTER TABLE issues DROP COLUMN reopened_at;
+ALTER TABLE issues DROP COLUMN reopen_reason;
+ALTER TABLE issues DROP COLUMN reopened_by;
+ALTER TABLE issues DROP COLUMN reopen_count;
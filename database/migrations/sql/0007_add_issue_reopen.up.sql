@@ -0,0 +1,4 @@
+ALTER TABLE issues ADD COLUMN reopen_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE issues ADD COLUMN reopened_by INTEGER REFERENCES users(id);
+ALTER TABLE issues ADD COLUMN reopen_reason TEXT;
+ALTER TABLE issues ADD COLUMN reopened_at TEXT;
@@ -0,0 +1,2 @@
+DROP INDEX idx_issue_history_issue_id;
+DROP TABLE issue_history;
@@ -0,0 +1,14 @@
+CREATE TABLE issue_history (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	issue_id   INTEGER NOT NULL REFERENCES issues(id),
+	action     TEXT    NOT NULL,
+	field      TEXT    NOT NULL,
+	old_value  TEXT,
+	new_value  TEXT,
+	actor_id   INTEGER REFERENCES users(id),
+	request_id TEXT    NOT NULL DEFAULT '',
+	comment    TEXT    NOT NULL DEFAULT '',
+	created_at TEXT    NOT NULL
+);
+
+CREATE INDEX idx_issue_history_issue_id ON issue_history(issue_id, id);
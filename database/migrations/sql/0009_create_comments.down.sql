@@ -0,0 +1,4 @@
+DROP INDEX idx_comment_revisions_comment_id;
+DROP TABLE comment_revisions;
+DROP INDEX idx_comments_issue_id;
+DROP TABLE comments;
@@ -0,0 +1,22 @@
+CREATE TABLE comments (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	issue_id   INTEGER NOT NULL REFERENCES issues(id),
+	parent_id  INTEGER REFERENCES comments(id),
+	author_id  INTEGER NOT NULL REFERENCES users(id),
+	body       TEXT    NOT NULL,
+	version    INTEGER NOT NULL DEFAULT 1,
+	created_at TEXT    NOT NULL,
+	updated_at TEXT    NOT NULL,
+	deleted_at TEXT
+);
+
+CREATE INDEX idx_comments_issue_id ON comments(issue_id, id);
+
+CREATE TABLE comment_revisions (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	comment_id INTEGER NOT NULL REFERENCES comments(id),
+	body       TEXT    NOT NULL,
+	created_at TEXT    NOT NULL
+);
+
+CREATE INDEX idx_comment_revisions_comment_id ON comment_revisions(comment_id, id);
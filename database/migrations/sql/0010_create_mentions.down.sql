@@ -0,0 +1,3 @@
+DROP INDEX idx_mentions_issue_id;
+DROP INDEX idx_mentions_user_id;
+DROP TABLE mentions;
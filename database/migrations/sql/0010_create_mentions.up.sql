@@ -0,0 +1,10 @@
+CREATE TABLE mentions (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id    INTEGER NOT NULL REFERENCES users(id),
+	issue_id   INTEGER NOT NULL REFERENCES issues(id),
+	comment_id INTEGER REFERENCES comments(id),
+	created_at TEXT    NOT NULL
+);
+
+CREATE INDEX idx_mentions_user_id ON mentions(user_id, id);
+CREATE INDEX idx_mentions_issue_id ON mentions(issue_id, comment_id);
@@ -0,0 +1,8 @@
+ALTER TABLE users ADD COLUMN email TEXT;
+
+UPDATE users SET email = 'dev.kim@example.com' WHERE id = 1 AND name = '김개발';
+UPDATE users SET email = 'design.lee@example.com' WHERE id = 2 AND name = '이디자인';
+UPDATE users SET email = 'plan.park@example.com' WHERE id = 3 AND name = '박기획';
+
+CREATE UNIQUE INDEX idx_users_name ON users(name);
+CREATE UNIQUE INDEX idx_users_email ON users(email);
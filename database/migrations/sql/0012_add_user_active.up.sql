@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN active INTEGER NOT NULL DEFAULT 1;
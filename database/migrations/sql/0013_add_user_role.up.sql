@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'member' CHECK (role IN ('admin', 'member', 'reporter', 'viewer'));
+
+UPDATE users SET role = 'admin' WHERE id = 1 AND name = '김개발';
+UPDATE users SET role = 'reporter' WHERE id = 3 AND name = '박기획';
@@ -0,0 +1,2 @@
+DROP INDEX idx_issues_reporter_id;
+ALTER TABLE issues DROP COLUMN reporter_id;
@@ -0,0 +1,3 @@
+ALTER TABLE issues ADD COLUMN reporter_id INTEGER REFERENCES users(id);
+
+CREATE INDEX idx_issues_reporter_id ON issues(reporter_id);
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const timeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// SQLite의 lower()는 ASCII만 소문자로 바꾸므로, 메모리 저장소의 strings.ToLower와
+// 같은 결과를 내도록 Go 함수를 unicode_lower라는 이름으로 등록해 쓴다.
+func init() {
+	sqlite.MustRegisterDeterministicScalarFunction("unicode_lower", 1, unicodeLower)
+}
+
+func unicodeLower(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	switch value := args[0].(type) {
+	case string:
+		return strings.ToLower(value), nil
+	case []byte:
+		return strings.ToLower(string(value)), nil
+	default:
+		return value, nil
+	}
+}
+
+func OpenSQLite(path string) (*sql.DB, error) {
+	return open(path + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)")
+}
+
+// 이슈를 이벤트 로그에 두고 사용자·댓글·멘션만 SQLite에 둘 때 쓴다.
+// issues 테이블이 비어 있으므로 이를 참조하는 외래 키는 검사하지 않는다.
+func OpenSQLiteWithoutForeignKeys(path string) (*sql.DB, error) {
+	return open(path + "?_pragma=foreign_keys(0)&_pragma=busy_timeout(5000)")
+}
+
+func open(dsn string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite는 단일 writer만 허용하므로 커넥션을 하나로 제한한다
+	db.SetMaxOpenConns(1)
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// column은 "users.name"처럼 테이블 이름을 붙여 쓴다
+func IsUniqueViolation(err error, column string) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) &&
+		sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE &&
+		strings.Contains(sqliteErr.Error(), column)
+}
+
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+func ParseTime(value string) (time.Time, error) {
+	return time.Parse(timeLayout, value)
+}
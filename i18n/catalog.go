@@ -0,0 +1,18 @@
+package i18n
+
+type Catalog map[string]map[Language]string
+
+// 요청한 언어의 메시지가 없으면 영어, 한국어 순으로 찾는다
+func (c Catalog) Lookup(key string, language Language) (string, bool) {
+	messages, ok := c[key]
+	if !ok {
+		return "", false
+	}
+
+	for _, candidate := range []Language{language, FallbackLanguage, DefaultLanguage} {
+		if message, ok := messages[candidate]; ok {
+			return message, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,78 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Language string
+
+const (
+	Korean  Language = "ko"
+	English Language = "en"
+)
+
+// Accept-Language가 없으면 한국어를, 지원하지 않는 언어만 요청하면 영어를 사용한다
+const (
+	DefaultLanguage  = Korean
+	FallbackLanguage = English
+)
+
+var supportedLanguages = []Language{Korean, English}
+
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+func Negotiate(acceptLanguage string) Language {
+	ranges := parseAcceptLanguage(acceptLanguage)
+	if len(ranges) == 0 {
+		return DefaultLanguage
+	}
+
+	for _, r := range ranges {
+		if r.tag == "*" {
+			return DefaultLanguage
+		}
+		primary, _, _ := strings.Cut(r.tag, "-")
+		for _, language := range supportedLanguages {
+			if primary == string(language) {
+				return language
+			}
+		}
+	}
+	return FallbackLanguage
+}
+
+// q=0인 항목은 "허용하지 않음"이므로 제외하고, 품질값 내림차순으로 정렬한다
+func parseAcceptLanguage(header string) []languageRange {
+	var ranges []languageRange
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, languageRange{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
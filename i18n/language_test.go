@@ -0,0 +1,37 @@
+package i18n
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected Language
+	}{
+		{"", Korean},
+		{"ko-KR,ko;q=0.9", Korean},
+		{"en-US,en;q=0.9", English},
+		{"ko;q=0.5, en;q=0.8", English},
+		{"fr-FR, de;q=0.7", English},
+		{"fr, ko;q=0.3", Korean},
+		{"en;q=0, ko;q=0.1", Korean},
+		{"*", Korean},
+	}
+
+	for _, tt := range tests {
+		if actual := Negotiate(tt.header); actual != tt.expected {
+			t.Errorf("Accept-Language %q: 예상 %s, 실제 %s", tt.header, tt.expected, actual)
+		}
+	}
+}
+
+func TestCatalog_Lookup_요청_언어가_없으면_영어로_대체(t *testing.T) {
+	catalog := Catalog{
+		"ONLY_ENGLISH": {English: "English only"},
+	}
+
+	message, ok := catalog.Lookup("ONLY_ENGLISH", Korean)
+
+	if !ok || message != "English only" {
+		t.Errorf("영어 메시지로 대체되어야 함. 실제: %q", message)
+	}
+}
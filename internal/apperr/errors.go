@@ -0,0 +1,71 @@
+// Package apperr는 여러 도메인이 함께 쓰는 에러 타입을 모은다.
+// 도메인별 에러 값은 각 도메인 패키지에 두고, 이 패키지의 타입으로 만든다.
+package apperr
+
+import "strings"
+
+// 에러 코드는 API 응답의 errorCode로 그대로 노출되므로 한 번 정한 값은 바꾸지 않는다
+type CodedError interface {
+	error
+	ErrorCode() string
+}
+
+type DomainError struct {
+	Code    string
+	Message string
+}
+
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+func (e *DomainError) ErrorCode() string {
+	return e.Code
+}
+
+const CodeValidationFailed = "VALIDATION_FAILED"
+
+// Reason은 같은 필드에서 발생한 검증 실패를 구분하는 선택 값이다 (예: TYPE_MISMATCH)
+type ValidationError struct {
+	Field   string
+	Reason  string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func (e *ValidationError) ErrorCode() string {
+	return CodeValidationFailed
+}
+
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e ValidationErrors) ErrorCode() string {
+	return CodeValidationFailed
+}
+
+const CodePermissionDenied = "PERMISSION_DENIED"
+
+// Reason은 거부된 이유이며 API 응답의 reason으로 그대로 노출된다 (예: TRANSITION_REQUIRES_ADMIN)
+type PermissionError struct {
+	Reason  string
+	Message string
+}
+
+func (e *PermissionError) Error() string {
+	return e.Message
+}
+
+func (e *PermissionError) ErrorCode() string {
+	return CodePermissionDenied
+}
@@ -0,0 +1,56 @@
+package httpx
+
+import (
+	"errors"
+	"strings"
+
+	userApp "issue-service-aoroa/user/application"
+	userModel "issue-service-aoroa/user/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	APIKeyHeader         = "X-API-Key"
+	authenticatedUserKey = "authenticatedUser"
+)
+
+// Authorization: Bearer <JWT> 또는 X-API-Key 헤더로 사용자를 인증하고, 인증된 사용자를 컨텍스트에 넣는다.
+// 둘 다 보내면 API 키를 쓴다. 인증하지 못하면 다음 핸들러를 실행하지 않는다.
+func Authenticate(authenticator userApp.Authenticator) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := authenticate(ctx, authenticator)
+		if err != nil {
+			if !errors.Is(err, userApp.ErrUserInactive) {
+				ctx.Header("WWW-Authenticate", "Bearer")
+			}
+			ctx.Error(err)
+			ctx.Abort()
+			return
+		}
+		ctx.Set(authenticatedUserKey, user)
+		ctx.Next()
+	}
+}
+
+// Authenticate를 거치지 않은 요청이면 nil을 반환한다
+func AuthenticatedUser(ctx *gin.Context) *userModel.User {
+	user, _ := ctx.Value(authenticatedUserKey).(*userModel.User)
+	return user
+}
+
+func authenticate(ctx *gin.Context, authenticator userApp.Authenticator) (*userModel.User, error) {
+	if key := ctx.GetHeader(APIKeyHeader); key != "" {
+		return authenticator.AuthenticateAPIKey(key)
+	}
+
+	authorization := ctx.GetHeader("Authorization")
+	if authorization == "" {
+		return nil, userApp.ErrAuthenticationRequired
+	}
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, userApp.ErrInvalidCredentials
+	}
+	return authenticator.AuthenticateToken(strings.TrimSpace(token))
+}
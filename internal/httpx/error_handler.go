@@ -0,0 +1,151 @@
+package httpx
+
+import (
+	"errors"
+	"net/http"
+
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/apperr"
+	userApp "issue-service-aoroa/user/application"
+
+	"github.com/gin-gonic/gin"
+)
+
+const codeInternalError = "INTERNAL_ERROR"
+
+// 모든 도메인의 컨트롤러가 함께 쓰는 요청 형식 에러
+var (
+	ErrInvalidRequest = &apperr.DomainError{
+		Code:    "INVALID_REQUEST",
+		Message: "잘못된 요청 데이터입니다",
+	}
+	ErrInvalidID = &apperr.DomainError{
+		Code:    "INVALID_ID",
+		Message: "잘못된 ID 형식입니다",
+	}
+)
+
+type ErrorResponse struct {
+	Error     string           `json:"error"`
+	Code      int              `json:"code"`
+	ErrorCode string           `json:"errorCode"`
+	Field     string           `json:"field,omitempty"`
+	Reason    string           `json:"reason,omitempty"`
+	Position  *int             `json:"position,omitempty"`
+	Fields    []FieldErrorBody `json:"fields,omitempty"`
+}
+
+type FieldErrorBody struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error"`
+}
+
+// ErrorMapping은 한 도메인의 에러를 응답으로 바꾸는 규칙이며, 각 도메인의 presentation 패키지가 제공한다.
+// Status는 그 도메인의 에러가 아니면 0을 반환한다. Messages는 에러 코드별 번역이다.
+// Describe는 선택이며, 응답에 따로 채울 내용이 있는 에러면 response를 채우고 true를 반환한다.
+type ErrorMapping struct {
+	Status   func(err error) int
+	Messages i18n.Catalog
+	Describe func(err error, language i18n.Language, response *ErrorResponse) bool
+}
+
+// 핸들러가 ctx.Error로 남긴 마지막 에러를 HTTP 상태 코드와 에러 응답으로 변환한다.
+// 메시지는 Accept-Language로 협상한 언어로 번역되며 errorCode는 언어와 무관하게 유지된다.
+func ErrorHandler(mappings ...ErrorMapping) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		if len(ctx.Errors) == 0 || ctx.Writer.Written() {
+			return
+		}
+
+		language := i18n.Negotiate(ctx.GetHeader("Accept-Language"))
+		response := NewErrorResponse(ctx.Errors.Last().Err, language, mappings...)
+		ctx.Header("Content-Language", string(language))
+		ctx.JSON(response.Code, response)
+	}
+}
+
+func NewErrorResponse(err error, language i18n.Language, mappings ...ErrorMapping) ErrorResponse {
+	catalogs := catalogsOf(mappings)
+	status := statusOf(err, mappings)
+
+	var coded apperr.CodedError
+	if status == http.StatusInternalServerError || !errors.As(err, &coded) {
+		return ErrorResponse{
+			Error:     catalogs.message(codeInternalError, language, "서버 내부 오류입니다"),
+			Code:      http.StatusInternalServerError,
+			ErrorCode: codeInternalError,
+		}
+	}
+
+	response := ErrorResponse{
+		Code:      status,
+		ErrorCode: coded.ErrorCode(),
+	}
+
+	var validationErr *apperr.ValidationError
+	if errors.As(err, &validationErr) {
+		response.Field = validationErr.Field
+		response.Error = catalogs.validationMessage(validationErr, language)
+		return response
+	}
+
+	var permissionErr *apperr.PermissionError
+	if errors.As(err, &permissionErr) {
+		response.Reason = permissionErr.Reason
+		response.Error = catalogs.message(apperr.CodePermissionDenied+"."+permissionErr.Reason, language, permissionErr.Error())
+		return response
+	}
+
+	for _, mapping := range mappings {
+		if mapping.Describe != nil && mapping.Describe(err, language, &response) {
+			return response
+		}
+	}
+
+	var validationErrs apperr.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		for _, fieldErr := range validationErrs {
+			response.Fields = append(response.Fields, FieldErrorBody{
+				Field:  fieldErr.Field,
+				Reason: fieldErr.Reason,
+				Error:  catalogs.validationMessage(fieldErr, language),
+			})
+		}
+	}
+
+	response.Error = catalogs.message(response.ErrorCode, language, coded.Error())
+	return response
+}
+
+// 도메인 규칙을 먼저 적용하고, 어느 도메인도 모르는 에러는 공통 규칙으로 판단한다
+func statusOf(err error, mappings []ErrorMapping) int {
+	for _, mapping := range mappings {
+		if status := mapping.Status(err); status != 0 {
+			return status
+		}
+	}
+
+	var validationErr *apperr.ValidationError
+	var validationErrs apperr.ValidationErrors
+	var permissionErr *apperr.PermissionError
+
+	switch {
+	case errors.Is(err, userApp.ErrAuthenticationRequired),
+		errors.Is(err, userApp.ErrInvalidCredentials),
+		errors.Is(err, userApp.ErrTokenExpired):
+		return http.StatusUnauthorized
+	case errors.Is(err, userApp.ErrUserInactive),
+		errors.As(err, &permissionErr):
+		return http.StatusForbidden
+	case errors.Is(err, ErrInvalidRequest),
+		errors.Is(err, ErrInvalidID),
+		errors.As(err, &validationErr),
+		errors.As(err, &validationErrs):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,159 @@
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/apperr"
+	userApp "issue-service-aoroa/user/application"
+
+	"github.com/gin-gonic/gin"
+)
+
+var errTestNotFound = &apperr.DomainError{Code: "THING_NOT_FOUND", Message: "찾을 수 없습니다"}
+
+// 도메인 규칙이 공통 규칙과 함께 적용되는지 확인하기 위한 시험용 도메인
+var testMapping = ErrorMapping{
+	Status: func(err error) int {
+		if errors.Is(err, errTestNotFound) {
+			return http.StatusNotFound
+		}
+		return 0
+	},
+	Messages: i18n.Catalog{
+		"THING_NOT_FOUND": {
+			i18n.Korean:  "찾을 수 없습니다",
+			i18n.English: "Thing not found",
+		},
+		"VALIDATION_FAILED.title": {
+			i18n.Korean:  "제목은 필수입니다",
+			i18n.English: "Title is required",
+		},
+	},
+}
+
+func performWithError(err error, acceptLanguage ...string) (*httptest.ResponseRecorder, ErrorResponse) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ErrorHandler(testMapping))
+	router.GET("/", func(ctx *gin.Context) {
+		ctx.Error(err)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, language := range acceptLanguage {
+		request.Header.Add("Accept-Language", language)
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	var response ErrorResponse
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	return recorder, response
+}
+
+func TestErrorHandler_공통_에러_상태코드_매핑(t *testing.T) {
+	tests := []struct {
+		err       error
+		status    int
+		errorCode string
+	}{
+		{ErrInvalidRequest, http.StatusBadRequest, "INVALID_REQUEST"},
+		{ErrInvalidID, http.StatusBadRequest, "INVALID_ID"},
+		{userApp.ErrAuthenticationRequired, http.StatusUnauthorized, "AUTHENTICATION_REQUIRED"},
+		{userApp.ErrInvalidCredentials, http.StatusUnauthorized, "INVALID_CREDENTIALS"},
+		{userApp.ErrTokenExpired, http.StatusUnauthorized, "TOKEN_EXPIRED"},
+		{userApp.ErrUserInactive, http.StatusForbidden, "USER_INACTIVE"},
+		{&apperr.PermissionError{Reason: "ADMIN_ONLY"}, http.StatusForbidden, "PERMISSION_DENIED"},
+		{&apperr.ValidationError{Field: "title", Message: "제목은 필수입니다"}, http.StatusBadRequest, "VALIDATION_FAILED"},
+		{apperr.ValidationErrors{{Field: "title", Message: "제목은 필수입니다"}}, http.StatusBadRequest, "VALIDATION_FAILED"},
+		{errTestNotFound, http.StatusNotFound, "THING_NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		recorder, response := performWithError(tt.err)
+
+		if recorder.Code != tt.status {
+			t.Errorf("%v: 예상 상태 코드 %d, 실제 %d", tt.err, tt.status, recorder.Code)
+		}
+		if response.ErrorCode != tt.errorCode {
+			t.Errorf("%v: 예상 에러 코드 %s, 실제 %s", tt.err, tt.errorCode, response.ErrorCode)
+		}
+	}
+}
+
+func TestErrorHandler_검증_에러_필드명_포함(t *testing.T) {
+	_, response := performWithError(&apperr.ValidationError{Field: "title", Message: "제목은 필수입니다"})
+
+	if response.Field != "title" {
+		t.Errorf("field는 title이어야 함. 실제: %s", response.Field)
+	}
+}
+
+func TestErrorHandler_권한_에러_사유_포함(t *testing.T) {
+	_, response := performWithError(&apperr.PermissionError{Reason: "ADMIN_ONLY", Message: "관리자만 할 수 있는 작업입니다"}, "en")
+
+	if response.Reason != "ADMIN_ONLY" {
+		t.Errorf("reason이 포함되어야 함. 실제: %s", response.Reason)
+	}
+	if response.Error != "Only an administrator can perform this action" {
+		t.Errorf("사유별 영어 메시지여야 함. 실제: %s", response.Error)
+	}
+}
+
+func TestErrorHandler_알_수_없는_에러는_내부_오류(t *testing.T) {
+	recorder, response := performWithError(errors.New("database is locked"))
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("500이어야 함. 실제: %d", recorder.Code)
+	}
+	if response.ErrorCode != codeInternalError || response.Error != "서버 내부 오류입니다" {
+		t.Errorf("내부 에러 정보가 노출되면 안 됨. 실제: %+v", response)
+	}
+}
+
+func TestErrorHandler_Accept_Language_영어_메시지(t *testing.T) {
+	recorder, response := performWithError(errTestNotFound, "en-US,en;q=0.9")
+
+	if response.Error != "Thing not found" {
+		t.Errorf("도메인 번역의 영어 메시지여야 함. 실제: %s", response.Error)
+	}
+	if response.ErrorCode != "THING_NOT_FOUND" {
+		t.Errorf("에러 코드는 언어와 무관해야 함. 실제: %s", response.ErrorCode)
+	}
+	if recorder.Header().Get("Content-Language") != "en" {
+		t.Errorf("Content-Language는 en이어야 함. 실제: %s", recorder.Header().Get("Content-Language"))
+	}
+}
+
+func TestErrorHandler_Accept_Language_없으면_한국어_메시지(t *testing.T) {
+	_, response := performWithError(&apperr.ValidationError{Field: "title", Message: "제목은 필수입니다"})
+
+	if response.Error != "제목은 필수입니다" {
+		t.Errorf("한국어 메시지여야 함. 실제: %s", response.Error)
+	}
+}
+
+func TestErrorHandler_검증_에러_필드별_영어_메시지(t *testing.T) {
+	_, response := performWithError(&apperr.ValidationError{Field: "title", Message: "제목은 필수입니다"}, "en")
+
+	if response.Error != "Title is required" {
+		t.Errorf("필드별 영어 메시지여야 함. 실제: %s", response.Error)
+	}
+}
+
+func TestErrorHandler_모든_공통_에러_코드에_영어_메시지_존재(t *testing.T) {
+	for code, messages := range commonMessages {
+		if _, ok := messages[i18n.English]; !ok {
+			t.Errorf("%s에 영어 메시지가 없음", code)
+		}
+		if _, ok := messages[i18n.Korean]; !ok {
+			t.Errorf("%s에 한국어 메시지가 없음", code)
+		}
+	}
+}
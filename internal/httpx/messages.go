@@ -0,0 +1,98 @@
+package httpx
+
+import (
+	"strings"
+
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/apperr"
+)
+
+// 도메인과 무관하게 쓰는 에러 코드의 번역. 도메인 에러의 번역은 각 도메인의 ErrorMapping에 둔다.
+// 검증 에러 메시지의 {field}는 실패한 필드 이름으로 치환된다.
+var commonMessages = i18n.Catalog{
+	"INVALID_REQUEST": {
+		i18n.Korean:  "잘못된 요청 데이터입니다",
+		i18n.English: "The request body is invalid",
+	},
+	"INVALID_ID": {
+		i18n.Korean:  "잘못된 ID 형식입니다",
+		i18n.English: "The ID format is invalid",
+	},
+	"VALIDATION_FAILED": {
+		i18n.Korean:  "입력값이 올바르지 않습니다",
+		i18n.English: "The input is invalid",
+	},
+	"PERMISSION_DENIED": {
+		i18n.Korean:  "권한이 없습니다",
+		i18n.English: "Permission denied",
+	},
+	"PERMISSION_DENIED.ADMIN_ONLY": {
+		i18n.Korean:  "관리자만 할 수 있는 작업입니다",
+		i18n.English: "Only an administrator can perform this action",
+	},
+	"AUTHENTICATION_REQUIRED": {
+		i18n.Korean:  "인증이 필요합니다",
+		i18n.English: "Authentication is required",
+	},
+	"INVALID_CREDENTIALS": {
+		i18n.Korean:  "인증 정보가 올바르지 않습니다",
+		i18n.English: "The credentials are invalid",
+	},
+	"TOKEN_EXPIRED": {
+		i18n.Korean:  "인증 토큰이 만료되었습니다",
+		i18n.English: "The authentication token has expired",
+	},
+	"USER_INACTIVE": {
+		i18n.Korean:  "비활성화된 사용자입니다",
+		i18n.English: "The user has been deactivated",
+	},
+	"INTERNAL_ERROR": {
+		i18n.Korean:  "서버 내부 오류입니다",
+		i18n.English: "Internal server error",
+	},
+}
+
+// 도메인 번역을 등록 순서대로 찾은 뒤 공통 번역을 찾는다
+type catalogs []i18n.Catalog
+
+func catalogsOf(mappings []ErrorMapping) catalogs {
+	result := make(catalogs, 0, len(mappings)+1)
+	for _, mapping := range mappings {
+		result = append(result, mapping.Messages)
+	}
+	return append(result, commonMessages)
+}
+
+func (c catalogs) lookup(key string, language i18n.Language) (string, bool) {
+	for _, catalog := range c {
+		if message, ok := catalog.Lookup(key, language); ok {
+			return message, true
+		}
+	}
+	return "", false
+}
+
+func (c catalogs) message(code string, language i18n.Language, fallback string) string {
+	if message, ok := c.lookup(code, language); ok {
+		return message
+	}
+	return fallback
+}
+
+// 검증 에러는 사유별 메시지, 필드별 메시지, 공통 메시지 순으로 찾는다
+func (c catalogs) validationMessage(err *apperr.ValidationError, language i18n.Language) string {
+	var keys []string
+	if err.Reason != "" {
+		keys = append(keys, apperr.CodeValidationFailed+"."+err.Reason)
+	}
+	if err.Field != "" {
+		keys = append(keys, apperr.CodeValidationFailed+"."+err.Field)
+	}
+
+	for _, key := range keys {
+		if message, ok := c.lookup(key, language); ok {
+			return strings.ReplaceAll(message, "{field}", err.Field)
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,54 @@
+package httpx
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	requestIDKey    = "requestID"
+
+	maxRequestIDLength = 128
+)
+
+// 클라이언트가 보낸 X-Request-ID를 그대로 쓰고, 없거나 쓸 수 없는 값이면 새로 만든다.
+// 요청 ID는 응답 헤더와 변경 이력에 남는다.
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.GetHeader(RequestIDHeader)
+		if !isValidRequestID(id) {
+			id = newRequestID()
+		}
+
+		ctx.Set(requestIDKey, id)
+		ctx.Header(RequestIDHeader, id)
+		ctx.Next()
+	}
+}
+
+// RequestID를 거치지 않은 요청이면 빈 문자열을 반환한다
+func RequestIDOf(ctx *gin.Context) string {
+	return ctx.GetString(requestIDKey)
+}
+
+// 로그와 저장소에 그대로 남기므로 출력 가능한 ASCII만 허용한다
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,46 @@
+package application
+
+import "issue-service-aoroa/internal/apperr"
+
+var (
+	ErrIssueNotFound = &apperr.DomainError{
+		Code:    "ISSUE_NOT_FOUND",
+		Message: "이슈를 찾을 수 없습니다",
+	}
+	ErrUserNotFound = &apperr.DomainError{
+		Code:    "USER_NOT_FOUND",
+		Message: "사용자를 찾을 수 없습니다",
+	}
+	ErrAssigneeInactive = &apperr.DomainError{
+		Code:    "ASSIGNEE_INACTIVE",
+		Message: "비활성화된 사용자에게는 이슈를 할당할 수 없습니다",
+	}
+	ErrTimeTravelUnsupported = &apperr.DomainError{
+		Code:    "TIME_TRAVEL_UNSUPPORTED",
+		Message: "현재 저장소에서는 과거 시점 조회를 지원하지 않습니다",
+	}
+)
+
+// 역할 때문에 거부된 이슈 작업. 모두 403 PERMISSION_DENIED이며 reason으로 구분한다
+var (
+	ErrReadOnlyRole = &apperr.PermissionError{
+		Reason:  "READ_ONLY_ROLE",
+		Message: "조회 권한만 있는 사용자는 이슈를 변경할 수 없습니다",
+	}
+	ErrReporterCreateOnly = &apperr.PermissionError{
+		Reason:  "REPORTER_CREATE_ONLY",
+		Message: "보고자는 이슈를 등록만 할 수 있습니다",
+	}
+	ErrTransitionRequiresAssignee = &apperr.PermissionError{
+		Reason:  "TRANSITION_REQUIRES_ASSIGNEE",
+		Message: "담당자나 관리자만 실행할 수 있는 상태 변경입니다",
+	}
+	ErrTransitionRequiresAdmin = &apperr.PermissionError{
+		Reason:  "TRANSITION_REQUIRES_ADMIN",
+		Message: "관리자만 실행할 수 있는 상태 변경입니다",
+	}
+	ErrAdminOnly = &apperr.PermissionError{
+		Reason:  "ADMIN_ONLY",
+		Message: "관리자만 할 수 있는 작업입니다",
+	}
+)
@@ -0,0 +1,58 @@
+package application
+
+import (
+	"time"
+
+	"issue-service-aoroa/issue/model"
+	userApp "issue-service-aoroa/user/application"
+	userModel "issue-service-aoroa/user/model"
+)
+
+// 변경 이력에 남길 요청 정보. Actor는 인증된 사용자가 없으면 nil이다
+type AuditContext struct {
+	Actor     *userModel.User
+	RequestID string
+}
+
+// 같은 저장소를 쓰되 변경 이력에 audit 정보를 남기는 서비스를 반환한다
+func (s *issueService) WithAudit(audit AuditContext) IssueService {
+	return s.withAudit(audit)
+}
+
+// 사용자 서비스가 비활성화를 요청한 사용자와 요청 ID를 넘긴 이슈의 이력에 남길 때 쓴다
+func (s *issueService) HandOffAs(actor *userModel.User, requestID string) userApp.IssueHandOff {
+	return s.withAudit(AuditContext{Actor: actor, RequestID: requestID})
+}
+
+func (s *issueService) withAudit(audit AuditContext) *issueService {
+	copied := *s
+	copied.audit = audit
+	return &copied
+}
+
+func (s *issueService) GetIssueHistory(id uint) ([]model.HistoryEntry, error) {
+	if _, err := s.findIssueByID(id); err != nil {
+		return nil, err
+	}
+	return s.issueRepo.History(id)
+}
+
+// before가 nil이면 생성 이력을 만든다
+func (a AuditContext) entries(action string, before, after *model.Issue, comment string, at time.Time) []model.HistoryEntry {
+	changes := model.ChangesBetween(before, after)
+	entries := make([]model.HistoryEntry, 0, len(changes))
+	for _, change := range changes {
+		entries = append(entries, model.HistoryEntry{
+			IssueID:   after.ID,
+			Action:    action,
+			Field:     change.Field,
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			Actor:     a.Actor,
+			RequestID: a.RequestID,
+			Comment:   comment,
+			At:        at,
+		})
+	}
+	return entries
+}
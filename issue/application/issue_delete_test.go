@@ -0,0 +1,122 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+)
+
+func TestDeleteIssue_성공_목록과_검색에서_제외(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		deleted, _ := service.CreateIssue("삭제할 버그", "", nil)
+		service.CreateIssue("남길 버그", "", nil)
+
+		if err := service.DeleteIssue(deleted.ID, nil); err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		counts := func(includeDeleted bool) []int {
+			all, _ := service.GetAllIssues(includeDeleted)
+			pending, _ := service.GetIssuesByStatus(model.StatusPending, includeDeleted)
+			list, _ := service.ListIssues(ListIssuesQuery{Filter: infrastructure.IssueFilter{IncludeDeleted: includeDeleted}})
+			searched, _ := service.SearchIssues(SearchIssuesQuery{Query: "title ~ 버그", IncludeDeleted: includeDeleted})
+			texts, _ := service.SearchIssuesByText(TextSearchQuery{Text: "버그", IncludeDeleted: includeDeleted})
+			return []int{len(all), len(pending), list.Total, searched.Total, texts.Total}
+		}
+
+		for i, count := range counts(false) {
+			if count != 1 {
+				t.Errorf("조회 방법 %d: 삭제된 이슈는 제외되어야 함. 실제: %d건", i, count)
+			}
+		}
+		for i, count := range counts(true) {
+			if count != 2 {
+				t.Errorf("조회 방법 %d: includeDeleted이면 삭제된 이슈도 포함되어야 함. 실제: %d건", i, count)
+			}
+		}
+
+		issue, err := service.GetIssueByID(deleted.ID)
+		if err != nil || issue.DeletedAt == nil {
+			t.Errorf("상세 조회는 삭제 시각과 함께 반환되어야 함. 실제: %+v, %v", issue, err)
+		}
+	})
+}
+
+func TestDeleteIssue_실패_삭제된_이슈는_수정_불가(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("이슈", "", nil)
+		service.DeleteIssue(issue.ID, nil)
+
+		_, err := service.UpdateIssue(issue.ID, model.NewUpdateCommand().WithTitle("새 제목"), nil)
+		if !errors.Is(err, model.ErrIssueDeleted) {
+			t.Errorf("삭제된 이슈 수정 에러가 발생해야 함. 실제: %v", err)
+		}
+		if err := service.DeleteIssue(issue.ID, nil); !errors.Is(err, model.ErrIssueDeleted) {
+			t.Errorf("이미 삭제된 이슈 에러가 발생해야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestDeleteIssue_실패_버전_불일치(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("이슈", "", nil)
+		stale := issue.Version + 1
+
+		err := service.DeleteIssue(issue.ID, &stale)
+
+		var conflictErr *model.VersionConflictError
+		if !errors.As(err, &conflictErr) {
+			t.Errorf("버전 충돌 에러가 발생해야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestRestoreIssue_성공(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		userID := uint(1)
+		issue, _ := service.CreateIssue("이슈", "", &userID)
+		service.DeleteIssue(issue.ID, nil)
+
+		restored, err := service.RestoreIssue(issue.ID, nil)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if restored.DeletedAt != nil || restored.Status != model.StatusInProgress || restored.User == nil {
+			t.Errorf("삭제 전 상태로 복원되어야 함. 실제: %+v", restored)
+		}
+		if all, _ := service.GetAllIssues(false); len(all) != 1 {
+			t.Errorf("복원된 이슈는 목록에 다시 나타나야 함. 실제: %d건", len(all))
+		}
+
+		if _, err := service.RestoreIssue(issue.ID, nil); !errors.Is(err, model.ErrIssueNotDeleted) {
+			t.Errorf("삭제되지 않은 이슈 에러가 발생해야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestPurgeIssue(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("완전히 지울 이슈", "", nil)
+
+		if err := service.PurgeIssue(issue.ID); !errors.Is(err, model.ErrIssueNotDeleted) {
+			t.Errorf("삭제되지 않은 이슈는 완전 삭제할 수 없어야 함. 실제: %v", err)
+		}
+
+		service.DeleteIssue(issue.ID, nil)
+		if err := service.PurgeIssue(issue.ID); err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		if _, err := service.GetIssueByID(issue.ID); !errors.Is(err, ErrIssueNotFound) {
+			t.Errorf("완전 삭제된 이슈는 조회되지 않아야 함. 실제: %v", err)
+		}
+		if texts, _ := service.SearchIssuesByText(TextSearchQuery{Text: "이슈", IncludeDeleted: true}); texts.Total != 0 {
+			t.Errorf("검색 색인에서도 지워져야 함. 실제: %d건", texts.Total)
+		}
+		if err := service.PurgeIssue(issue.ID); !errors.Is(err, ErrIssueNotFound) {
+			t.Errorf("이슈를 찾을 수 없음 에러가 발생해야 함. 실제: %v", err)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"time"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+	userModel "issue-service-aoroa/user/model"
+)
+
+// userID에게 할당된 진행 중인 이슈를 successorID에게 넘긴다. successorID가 nil이면 담당자를 해제해
+// 워크플로의 담당자 해제 규칙에 따라 상태가 바뀐다. 종료된 이슈와 삭제된 이슈는 그대로 둔다.
+// 모든 이슈를 한 번에 저장하므로, 하나라도 저장할 수 없으면 어느 이슈도 바뀌지 않는다
+func (s *issueService) HandOffIssues(userID uint, successorID *uint) ([]model.Issue, error) {
+	if err := authorizeAdmin(s.audit.Actor); err != nil {
+		return nil, err
+	}
+	if _, err := s.findUserByID(userID); err != nil {
+		return nil, err
+	}
+
+	var successor *userModel.User
+	if successorID != nil {
+		if *successorID == userID {
+			return nil, &apperr.ValidationError{Field: "successorId", Reason: "SELF_SUCCESSOR", Message: "자기 자신에게는 이슈를 넘길 수 없습니다"}
+		}
+		var err error
+		if successor, err = s.findAssignee(*successorID); err != nil {
+			return nil, err
+		}
+	}
+
+	issues, err := s.issueRepo.GetAll(false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	updates := []infrastructure.IssueUpdate{}
+	for _, issue := range issues {
+		if issue.User == nil || issue.User.ID != userID || !issue.IsUpdatable() {
+			continue
+		}
+
+		before := issue
+		if successor != nil {
+			err = issue.AssignTo(successor)
+		} else {
+			err = issue.Unassign()
+		}
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, infrastructure.IssueUpdate{
+			Issue:   issue,
+			History: s.audit.entries(model.HistoryUpdated, &before, &issue, "", now),
+		})
+	}
+
+	if len(updates) == 0 {
+		return []model.Issue{}, nil
+	}
+	return s.issueRepo.UpdateAll(updates)
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+	mentionApp "issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	userInfra "issue-service-aoroa/user/infrastructure"
+)
+
+// 사용자를 비활성화해야 하므로 사용자 저장소도 함께 넘긴다
+func forEachRepositoryWithUsers(t *testing.T, test func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository, userRepo userInfra.UserRepository)) {
+	for _, factory := range repositoryFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			issueRepo, userRepo := factory.new(t)
+			mentions := mentionApp.NewMentionService(mentionInfra.NewMentionRepository(), issueRepo, userRepo)
+			test(t, as(NewIssueService(issueRepo, userRepo, commentInfra.NewCommentRepository(), mentions), testAdmin), issueRepo, userRepo)
+		})
+	}
+}
+
+func deactivate(t *testing.T, userRepo userInfra.UserRepository, userID uint) {
+	t.Helper()
+	user, _ := userRepo.GetByID(userID)
+	user.Active = false
+	if _, err := userRepo.Update(*user); err != nil {
+		t.Fatalf("사용자 비활성화 실패: %v", err)
+	}
+}
+
+func TestAssign_실패_비활성화된_사용자(t *testing.T) {
+	forEachRepositoryWithUsers(t, func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository, userRepo userInfra.UserRepository) {
+		issue, _ := service.CreateIssue("로그인 오류", "설명", nil)
+		deactivate(t, userRepo, 1)
+
+		userID := uint(1)
+		if _, err := service.CreateIssue("결제 오류", "설명", &userID); !errors.Is(err, ErrAssigneeInactive) {
+			t.Errorf("비활성화된 사용자를 담당자로 만들 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := service.UpdateIssue(issue.ID, model.NewUpdateCommand().WithUserID(1), nil); !errors.Is(err, ErrAssigneeInactive) {
+			t.Errorf("비활성화된 사용자에게 할당할 수 없어야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestHandOffIssues_후임자에게_넘김(t *testing.T) {
+	forEachRepositoryWithUsers(t, func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository, userRepo userInfra.UserRepository) {
+		userID := uint(1)
+		open, _ := service.CreateIssue("진행 중", "설명", &userID)
+		completed, _ := service.CreateIssue("완료", "설명", &userID)
+		service.UpdateIssue(completed.ID, model.NewUpdateCommand().WithStatus(model.StatusCompleted), nil)
+		deleted, _ := service.CreateIssue("삭제", "설명", &userID)
+		service.DeleteIssue(deleted.ID, nil)
+		otherID := uint(3)
+		other, _ := service.CreateIssue("다른 사람", "설명", &otherID)
+
+		successorID := uint(2)
+		handedOff, err := service.HandOffAs(testAdmin, "req-handoff").HandOffIssues(1, &successorID)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if len(handedOff) != 1 || handedOff[0].ID != open.ID || handedOff[0].User.ID != 2 || handedOff[0].Status != model.StatusInProgress {
+			t.Fatalf("진행 중인 이슈만 후임자에게 넘어가야 함: %+v", handedOff)
+		}
+
+		for _, id := range []uint{completed.ID, deleted.ID} {
+			if issue, _ := service.GetIssueByID(id); issue.User.ID != 1 {
+				t.Errorf("종료되거나 삭제된 이슈 %d는 그대로여야 함: %+v", id, issue.User)
+			}
+		}
+		if issue, _ := service.GetIssueByID(other.ID); issue.User.ID != 3 {
+			t.Errorf("다른 사용자의 이슈는 그대로여야 함: %+v", issue.User)
+		}
+		history, _ := service.GetIssueHistory(open.ID)
+		if entry := history[len(history)-1]; entry.Field != "assignee" || entry.Actor == nil || entry.Actor.ID != testAdmin.ID || entry.RequestID != "req-handoff" {
+			t.Errorf("담당자 변경 이력이 넘긴 사용자와 요청 ID와 함께 남아야 함: %+v", entry)
+		}
+	})
+}
+
+func TestHandOffIssues_후임자가_없으면_담당자_해제(t *testing.T) {
+	forEachRepositoryWithUsers(t, func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository, userRepo userInfra.UserRepository) {
+		userID := uint(1)
+		issue, _ := service.CreateIssue("진행 중", "설명", &userID)
+
+		handedOff, err := service.HandOffIssues(1, nil)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if len(handedOff) != 1 || handedOff[0].User != nil || handedOff[0].Status != model.StatusPending {
+			t.Errorf("담당자가 해제되고 대기 상태가 되어야 함: %+v", handedOff)
+		}
+		if after, _ := service.GetIssueByID(issue.ID); after.User != nil {
+			t.Errorf("저장된 이슈의 담당자도 해제되어야 함: %+v", after.User)
+		}
+	})
+}
+
+func TestHandOffIssues_실패(t *testing.T) {
+	forEachRepositoryWithUsers(t, func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository, userRepo userInfra.UserRepository) {
+		userID := uint(1)
+		issue, _ := service.CreateIssue("진행 중", "설명", &userID)
+		self, missing, inactive := uint(1), uint(999), uint(3)
+		deactivate(t, userRepo, inactive)
+
+		var validationErr *apperr.ValidationError
+		if _, err := service.HandOffIssues(1, &self); !errors.As(err, &validationErr) || validationErr.Reason != "SELF_SUCCESSOR" {
+			t.Errorf("자기 자신에게는 넘길 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := service.HandOffIssues(1, &missing); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("없는 후임자는 USER_NOT_FOUND여야 함. 실제: %v", err)
+		}
+		if _, err := service.HandOffIssues(1, &inactive); !errors.Is(err, ErrAssigneeInactive) {
+			t.Errorf("비활성화된 후임자에게는 넘길 수 없어야 함. 실제: %v", err)
+		}
+		if after, _ := service.GetIssueByID(issue.ID); after.User.ID != 1 || after.Version != issue.Version {
+			t.Errorf("실패하면 이슈가 바뀌지 않아야 함: %+v", after)
+		}
+	})
+}
+
+func TestIssueRepository_UpdateAll_하나라도_충돌하면_저장하지_않음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository) {
+		first, _ := service.CreateIssue("첫 이슈", "설명", nil)
+		second, _ := service.CreateIssue("둘째 이슈", "설명", nil)
+		stale := *second
+		service.UpdateIssue(second.ID, model.NewUpdateCommand().WithTitle("먼저 바뀐 제목"), nil)
+
+		first.Title, stale.Title = "함께 바꾼 제목", "함께 바꾼 제목"
+		_, err := issueRepo.UpdateAll([]infrastructure.IssueUpdate{{Issue: *first}, {Issue: stale}})
+		var conflictErr *model.VersionConflictError
+		if !errors.As(err, &conflictErr) || conflictErr.IssueID != second.ID {
+			t.Fatalf("버전 충돌이어야 함. 실제: %v", err)
+		}
+		if after, _ := service.GetIssueByID(first.ID); after.Title != "첫 이슈" || after.Version != 1 {
+			t.Errorf("충돌하지 않은 이슈도 저장되지 않아야 함: %+v", after)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+	userModel "issue-service-aoroa/user/model"
+)
+
+type historyLine struct {
+	action, field, oldValue, newValue string
+}
+
+func historyLines(entries []model.HistoryEntry) []historyLine {
+	value := func(v *string) string {
+		if v == nil {
+			return "<nil>"
+		}
+		return *v
+	}
+	lines := make([]historyLine, 0, len(entries))
+	for _, entry := range entries {
+		lines = append(lines, historyLine{entry.Action, entry.Field, value(entry.OldValue), value(entry.NewValue)})
+	}
+	return lines
+}
+
+func TestGetIssueHistory_성공_생성부터_삭제까지_기록(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		actor := &userModel.User{ID: 1, Name: "김개발", Role: userModel.RoleAdmin}
+		audited := service.WithAudit(AuditContext{Actor: actor, RequestID: "req-1"})
+
+		issue, _ := audited.CreateIssue("로그인 버그", "", nil)
+		audited.UpdateIssue(issue.ID, model.NewUpdateCommand().WithUserID(2), nil)
+		audited.TransitionIssue(issue.ID, TransitionIssueCommand{Name: "block", Comment: "API 대기"}, nil)
+		audited.DeleteIssue(issue.ID, nil)
+
+		history, err := service.GetIssueHistory(issue.ID)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		lines := historyLines(history)
+		expected := []historyLine{
+			{model.HistoryCreated, "title", "<nil>", "로그인 버그"},
+			{model.HistoryCreated, "status", "<nil>", "PENDING"},
+			{model.HistoryUpdated, "status", "PENDING", "IN_PROGRESS"},
+			{model.HistoryUpdated, "assignee", "<nil>", "2"},
+			{model.HistoryTransitioned, "status", "IN_PROGRESS", "BLOCKED"},
+			{model.HistoryDeleted, "deletedAt", "<nil>", lines[len(lines)-1].newValue},
+		}
+		if len(lines) != len(expected) {
+			t.Fatalf("예상 %v, 실제 %v", expected, lines)
+		}
+		for i := range expected {
+			if lines[i] != expected[i] {
+				t.Errorf("%d번째 이력: 예상 %v, 실제 %v", i, expected[i], lines[i])
+			}
+		}
+
+		for _, entry := range history {
+			if entry.Actor == nil || entry.Actor.Name != "김개발" || entry.RequestID != "req-1" || entry.At.IsZero() {
+				t.Errorf("행위자, 요청 ID, 시각이 기록되어야 함. 실제: %+v", entry)
+			}
+		}
+		if history[4].Comment != "API 대기" {
+			t.Errorf("전이 코멘트가 기록되어야 함. 실제: %q", history[4].Comment)
+		}
+	})
+}
+
+func TestGetIssueHistory_실패한_수정은_기록되지_않음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("이슈", "", nil)
+		stale := *issue
+		service.UpdateIssue(issue.ID, model.NewUpdateCommand().WithTitle("먼저 바꾼 제목"), nil)
+
+		stale.Title = "늦게 바꾼 제목"
+		history := []model.HistoryEntry{{Action: model.HistoryUpdated, Field: "title"}}
+		if _, err := issueRepo.Update(issue.ID, stale, history); err == nil {
+			t.Fatal("버전 충돌이 발생해야 함")
+		}
+
+		entries, _ := service.GetIssueHistory(issue.ID)
+		if len(entries) != 3 {
+			t.Errorf("충돌한 수정의 이력은 남지 않아야 함. 실제: %v", historyLines(entries))
+		}
+	})
+}
+
+func TestGetIssueHistory_완전_삭제된_이슈(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("이슈", "", nil)
+		service.DeleteIssue(issue.ID, nil)
+		service.PurgeIssue(issue.ID)
+
+		if _, err := service.GetIssueHistory(issue.ID); !errors.Is(err, ErrIssueNotFound) {
+			t.Errorf("이슈를 찾을 수 없음 에러가 발생해야 함. 실제: %v", err)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package application
+
+import (
+	"strings"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// Sort는 "필드" 또는 "필드:asc|desc" 형식이다. 비어 있으면 커서의 정렬을, 커서도 없으면 id 오름차순을 사용한다.
+type ListIssuesQuery struct {
+	Filter infrastructure.IssueFilter
+	Limit  int
+	Sort   string
+	Cursor string
+}
+
+type IssueList struct {
+	Issues     []model.Issue
+	NextCursor *string
+	Total      int
+}
+
+func (s *issueService) ListIssues(query ListIssuesQuery) (*IssueList, error) {
+	if err := validateFilter(query.Filter); err != nil {
+		return nil, err
+	}
+
+	page, err := buildPageRequest(query)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.issueRepo.Find(query.Filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIssueList(result), nil
+}
+
+func newIssueList(page infrastructure.IssuePage) *IssueList {
+	list := &IssueList{Issues: page.Issues, Total: page.Total}
+	if page.NextCursor != nil {
+		next := infrastructure.EncodeCursor(page.NextCursor)
+		list.NextCursor = &next
+	}
+	return list
+}
+
+func validateFilter(filter infrastructure.IssueFilter) error {
+	for _, status := range filter.Statuses {
+		if !model.IsValidStatus(status) {
+			return model.ErrInvalidStatus
+		}
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return &apperr.ValidationError{Field: "createdTo", Message: "종료 시각은 시작 시각보다 뒤여야 합니다"}
+	}
+	if filter.UpdatedFrom != nil && filter.UpdatedTo != nil && !filter.UpdatedFrom.Before(*filter.UpdatedTo) {
+		return &apperr.ValidationError{Field: "updatedTo", Message: "종료 시각은 시작 시각보다 뒤여야 합니다"}
+	}
+	return nil
+}
+
+func buildPageRequest(query ListIssuesQuery) (infrastructure.PageRequest, error) {
+	var sort *infrastructure.SortOrder
+	if query.Sort != "" {
+		parsed, err := parseSortOrder(query.Sort)
+		if err != nil {
+			return infrastructure.PageRequest{}, err
+		}
+		sort = &parsed
+	}
+	return newPageRequest(query.Limit, query.Cursor, sort)
+}
+
+// sort가 nil이면 커서의 정렬을, 커서도 없으면 id 오름차순을 사용한다
+func newPageRequest(limit int, cursor string, sort *infrastructure.SortOrder) (infrastructure.PageRequest, error) {
+	page := infrastructure.PageRequest{
+		Sort: infrastructure.SortOrder{Field: infrastructure.SortByID},
+	}
+
+	var err error
+	if page.Limit, err = pageLimit(limit); err != nil {
+		return page, err
+	}
+
+	if cursor != "" {
+		after, err := infrastructure.DecodeCursor(cursor)
+		if err != nil {
+			return page, err
+		}
+		page.After = after
+		page.Sort = after.Sort
+	}
+
+	if sort != nil {
+		if page.After != nil && page.After.Sort != *sort {
+			return page, infrastructure.ErrInvalidCursor
+		}
+		page.Sort = *sort
+	}
+
+	return page, nil
+}
+
+// 0이면 기본 페이지 크기를 사용한다
+func pageLimit(limit int) (int, error) {
+	if limit == 0 {
+		return DefaultPageLimit, nil
+	}
+	if limit < 1 || limit > MaxPageLimit {
+		return 0, &apperr.ValidationError{Field: "limit", Message: "limit은 1 이상 100 이하여야 합니다"}
+	}
+	return limit, nil
+}
+
+func parseSortOrder(value string) (infrastructure.SortOrder, error) {
+	invalidSort := &apperr.ValidationError{Field: "sort", Message: "지원하지 않는 정렬 기준입니다"}
+
+	field, direction, _ := strings.Cut(value, ":")
+	sort := infrastructure.SortOrder{Field: infrastructure.SortField(field)}
+	if !infrastructure.IsValidSortField(sort.Field) {
+		return sort, invalidSort
+	}
+
+	switch strings.ToLower(direction) {
+	case "", "asc":
+	case "desc":
+		sort.Descending = true
+	default:
+		return sort, invalidSort
+	}
+	return sort, nil
+}
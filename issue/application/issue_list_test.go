@@ -0,0 +1,224 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+)
+
+func collectTitles(t *testing.T, service IssueService, query ListIssuesQuery) []string {
+	var titles []string
+	for {
+		list, err := service.ListIssues(query)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		for _, issue := range list.Issues {
+			titles = append(titles, issue.Title)
+		}
+		if list.NextCursor == nil {
+			return titles
+		}
+		query.Cursor = *list.NextCursor
+	}
+}
+
+func TestListIssues_성공_커서로_모든_페이지_순회(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		for _, title := range []string{"다", "가", "마", "나", "라"} {
+			service.CreateIssue(title, "", nil)
+		}
+
+		first, _ := service.ListIssues(ListIssuesQuery{Limit: 2})
+		if first.Total != 5 || len(first.Issues) != 2 || first.NextCursor == nil {
+			t.Fatalf("첫 페이지는 2건, 전체 5건, 다음 커서가 있어야 함. 실제: %+v", first)
+		}
+
+		titles := collectTitles(t, service, ListIssuesQuery{Limit: 2, Sort: "title:desc"})
+		expected := []string{"마", "라", "다", "나", "가"}
+		if len(titles) != len(expected) {
+			t.Fatalf("모든 이슈가 한 번씩 조회되어야 함. 실제: %v", titles)
+		}
+		for i := range expected {
+			if titles[i] != expected[i] {
+				t.Fatalf("제목 내림차순이어야 함. 예상: %v, 실제: %v", expected, titles)
+			}
+		}
+	})
+}
+
+func TestListIssues_성공_페이지_사이에_추가된_이슈가_결과를_밀어내지_않음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		for _, title := range []string{"1", "2", "3", "4"} {
+			service.CreateIssue(title, "", nil)
+		}
+
+		first, _ := service.ListIssues(ListIssuesQuery{Limit: 2, Sort: "createdAt:desc"})
+		service.CreateIssue("새 이슈", "", nil)
+		second, err := service.ListIssues(ListIssuesQuery{Limit: 2, Cursor: *first.NextCursor})
+
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if len(second.Issues) != 2 || second.Issues[0].Title != "2" || second.Issues[1].Title != "1" {
+			t.Errorf("두 번째 페이지는 2, 1이어야 함. 실제: %+v", second.Issues)
+		}
+		if second.NextCursor != nil {
+			t.Error("마지막 페이지에는 다음 커서가 없어야 함")
+		}
+	})
+}
+
+func TestListIssues_성공_상태_필터와_전체_건수(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		userID := uint(1)
+		service.CreateIssue("진행중", "", &userID)
+		service.CreateIssue("대기1", "", nil)
+		service.CreateIssue("대기2", "", nil)
+
+		list, err := service.ListIssues(ListIssuesQuery{Filter: infrastructure.IssueFilter{Statuses: []string{model.StatusPending}}, Limit: 1})
+
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if list.Total != 2 || len(list.Issues) != 1 || list.Issues[0].Status != model.StatusPending {
+			t.Errorf("PENDING 2건 중 1건이 조회되어야 함. 실제: %+v", list)
+		}
+	})
+}
+
+func TestListIssues_실패_잘못된_페이지_요청(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		service.CreateIssue("1", "", nil)
+		service.CreateIssue("2", "", nil)
+		first, _ := service.ListIssues(ListIssuesQuery{Limit: 1, Sort: "title"})
+
+		tests := []struct {
+			name  string
+			query ListIssuesQuery
+			field string
+		}{
+			{"limit 초과", ListIssuesQuery{Limit: MaxPageLimit + 1}, "limit"},
+			{"지원하지 않는 정렬", ListIssuesQuery{Sort: "status"}, "sort"},
+			{"잘못된 정렬 방향", ListIssuesQuery{Sort: "id:up"}, "sort"},
+			{"손상된 커서", ListIssuesQuery{Cursor: "not-a-cursor"}, "cursor"},
+			{"커서와 다른 정렬", ListIssuesQuery{Cursor: *first.NextCursor, Sort: "id"}, "cursor"},
+		}
+
+		for _, tt := range tests {
+			_, err := service.ListIssues(tt.query)
+
+			var validationErr *apperr.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != tt.field {
+				t.Errorf("%s: %s 필드 검증 에러가 발생해야 함. 실제: %v", tt.name, tt.field, err)
+			}
+		}
+	})
+}
+
+func TestListIssues_성공_여러_조건_AND_결합(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		first, second := uint(1), uint(2)
+		service.CreateIssue("로그인 버그", "세션 만료", &first)
+		service.CreateIssue("결제 버그", "카드 오류", &second)
+		service.CreateIssue("로그인 화면 개선", "디자인 수정", nil)
+		service.CreateIssue("문서 정리", "로그인 가이드 작성", nil)
+
+		tests := []struct {
+			name     string
+			filter   infrastructure.IssueFilter
+			expected []string
+		}{
+			{
+				name:     "여러 상태",
+				filter:   infrastructure.IssueFilter{Statuses: []string{model.StatusPending, model.StatusInProgress}},
+				expected: []string{"로그인 버그", "결제 버그", "로그인 화면 개선", "문서 정리"},
+			},
+			{
+				name:     "담당자 1 또는 미할당",
+				filter:   infrastructure.IssueFilter{AssigneeIDs: []uint{1}, Unassigned: true},
+				expected: []string{"로그인 버그", "로그인 화면 개선", "문서 정리"},
+			},
+			{
+				name:     "제목 또는 설명 키워드",
+				filter:   infrastructure.IssueFilter{Keyword: "로그인"},
+				expected: []string{"로그인 버그", "로그인 화면 개선", "문서 정리"},
+			},
+			{
+				name:     "키워드와 미할당과 상태",
+				filter:   infrastructure.IssueFilter{Keyword: "로그인", Unassigned: true, Statuses: []string{model.StatusPending}},
+				expected: []string{"로그인 화면 개선", "문서 정리"},
+			},
+			{
+				name:     "담당자 2와 키워드",
+				filter:   infrastructure.IssueFilter{AssigneeIDs: []uint{2}, Keyword: "로그인"},
+				expected: nil,
+			},
+		}
+
+		for _, tt := range tests {
+			list, err := service.ListIssues(ListIssuesQuery{Filter: tt.filter})
+			if err != nil {
+				t.Fatalf("%s: 에러가 발생하지 않아야 함: %v", tt.name, err)
+			}
+			if len(list.Issues) != len(tt.expected) || list.Total != len(tt.expected) {
+				t.Errorf("%s: 예상 %v, 실제 %+v", tt.name, tt.expected, list.Issues)
+				continue
+			}
+			for i, issue := range list.Issues {
+				if issue.Title != tt.expected[i] {
+					t.Errorf("%s: 예상 %v, 실제 %+v", tt.name, tt.expected, list.Issues)
+					break
+				}
+			}
+		}
+	})
+}
+
+func TestListIssues_성공_키워드는_ASCII가_아닌_대소문자도_구분하지_않음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		service.CreateIssue("ÉCLAIR 화면 오류", "", nil)
+		service.CreateIssue("결제 버그", "Größe 계산 오류", nil)
+
+		for keyword, expected := range map[string]string{"éclair": "ÉCLAIR 화면 오류", "GRÖ": "결제 버그"} {
+			list, err := service.ListIssues(ListIssuesQuery{Filter: infrastructure.IssueFilter{Keyword: keyword}})
+			if err != nil {
+				t.Fatalf("%s: 에러가 발생하지 않아야 함: %v", keyword, err)
+			}
+			if len(list.Issues) != 1 || list.Issues[0].Title != expected {
+				t.Errorf("%s: %s만 조회되어야 함. 실제: %+v", keyword, expected, list.Issues)
+			}
+		}
+	})
+}
+
+func TestListIssues_성공_생성_시각_범위(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		old, _ := service.CreateIssue("이전 이슈", "", nil)
+		recent, _ := service.CreateIssue("최근 이슈", "", nil)
+
+		from := recent.CreatedAt
+		list, _ := service.ListIssues(ListIssuesQuery{Filter: infrastructure.IssueFilter{CreatedFrom: &from}})
+		if len(list.Issues) != 1 || list.Issues[0].ID != recent.ID {
+			t.Errorf("시작 시각 이후 이슈만 조회되어야 함. 실제: %+v", list.Issues)
+		}
+
+		to := recent.CreatedAt
+		list, _ = service.ListIssues(ListIssuesQuery{Filter: infrastructure.IssueFilter{CreatedTo: &to}})
+		if len(list.Issues) != 1 || list.Issues[0].ID != old.ID {
+			t.Errorf("종료 시각 이전 이슈만 조회되어야 함. 실제: %+v", list.Issues)
+		}
+	})
+}
+
+func TestListIssues_실패_잘못된_필터(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		_, err := service.ListIssues(ListIssuesQuery{Filter: infrastructure.IssueFilter{Statuses: []string{model.StatusPending, "DONE"}}})
+		if !errors.Is(err, model.ErrInvalidStatus) {
+			t.Errorf("유효하지 않은 상태 에러가 발생해야 함. 실제: %v", err)
+		}
+	})
+}
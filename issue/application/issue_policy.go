@@ -0,0 +1,86 @@
+package application
+
+import (
+	"issue-service-aoroa/issue/model"
+	userApp "issue-service-aoroa/user/application"
+	userModel "issue-service-aoroa/user/model"
+)
+
+// 역할별 이슈 권한
+//   - admin: 모든 작업
+//   - member: 등록과 수정. 워크플로에서 permission이 붙은 전이는 그 권한을 따르며(기본 워크플로에서는
+//     완료는 담당자만, 취소는 관리자만), 삭제·복원·다시 열기는 할 수 없다
+//   - reporter: 등록만
+//   - viewer: 조회만
+//
+// 검사는 audit의 Actor를 기준으로 한다. Actor가 없으면 누가 요청했는지 알 수 없으므로 모든 변경을 거부한다.
+// 다른 서비스가 이슈를 바꿀 때도 WithAudit이나 HandOffAs로 요청한 사용자를 넘겨야 한다.
+
+func authorizeCreate(actor *userModel.User) error {
+	if actor == nil {
+		return userApp.ErrAuthenticationRequired
+	}
+	if actor.Role == userModel.RoleViewer {
+		return ErrReadOnlyRole
+	}
+	return nil
+}
+
+// 변경 내용과 무관하게 역할만으로 거부할 수 있는 요청은 이슈를 바꿔 보기 전에 거부한다
+func authorizeUpdate(actor *userModel.User) error {
+	if actor == nil {
+		return userApp.ErrAuthenticationRequired
+	}
+	switch actor.Role {
+	case userModel.RoleViewer:
+		return ErrReadOnlyRole
+	case userModel.RoleReporter:
+		return ErrReporterCreateOnly
+	}
+	return nil
+}
+
+// 상태 변경은 워크플로에서 그 변경에 해당하는 전이의 permission을 따른다.
+// 담당자 지정·해제에 따른 자동 전이처럼 해당하는 전이가 없는 변경은 따로 검사하지 않는다
+func authorizeStatusChange(actor *userModel.User, before, after *model.Issue) error {
+	if actor == nil {
+		return userApp.ErrAuthenticationRequired
+	}
+	if before.Status == after.Status {
+		return nil
+	}
+	transition, ok := model.CurrentWorkflow().TransitionBetween(before.Status, after.Status)
+	if !ok {
+		return nil
+	}
+	return authorizeTransition(actor, before, transition)
+}
+
+// 담당자 권한은 전이하기 전의 담당자를 기준으로 판단한다
+func authorizeTransition(actor *userModel.User, before *model.Issue, transition model.Transition) error {
+	if actor == nil {
+		return userApp.ErrAuthenticationRequired
+	}
+	if actor.IsAdmin() {
+		return nil
+	}
+	switch transition.Permission {
+	case model.PermissionAssignee:
+		if before.User == nil || before.User.ID != actor.ID {
+			return ErrTransitionRequiresAssignee
+		}
+	case model.PermissionAdmin:
+		return ErrTransitionRequiresAdmin
+	}
+	return nil
+}
+
+func authorizeAdmin(actor *userModel.User) error {
+	if actor != nil && actor.IsAdmin() {
+		return nil
+	}
+	if err := authorizeUpdate(actor); err != nil {
+		return err
+	}
+	return ErrAdminOnly
+}
@@ -0,0 +1,275 @@
+package application
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+	userApp "issue-service-aoroa/user/application"
+	userModel "issue-service-aoroa/user/model"
+)
+
+var (
+	testAdmin    = &userModel.User{ID: 1, Name: "김개발", Role: userModel.RoleAdmin}
+	testMember   = &userModel.User{ID: 2, Name: "이디자인", Role: userModel.RoleMember}
+	testReporter = &userModel.User{ID: 3, Name: "박기획", Role: userModel.RoleReporter}
+	testViewer   = &userModel.User{ID: 4, Name: "최열람", Role: userModel.RoleViewer}
+)
+
+func as(service IssueService, actor *userModel.User) IssueService {
+	return service.WithAudit(AuditContext{Actor: actor})
+}
+
+func TestCreateIssue_권한(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		for _, actor := range []*userModel.User{testAdmin, testMember, testReporter} {
+			if _, err := as(service, actor).CreateIssue("로그인 오류", "설명", nil); err != nil {
+				t.Errorf("%s: 이슈를 등록할 수 있어야 함: %v", actor.Role, err)
+			}
+		}
+		if _, err := as(service, testViewer).CreateIssue("로그인 오류", "설명", nil); !errors.Is(err, ErrReadOnlyRole) {
+			t.Errorf("viewer는 이슈를 등록할 수 없어야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestUpdateIssue_실패_역할_때문에_수정할_수_없음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("로그인 오류", "설명", nil)
+		cmd := model.NewUpdateCommand().WithTitle("바꾼 제목")
+
+		if _, err := as(service, testViewer).UpdateIssue(issue.ID, cmd, nil); !errors.Is(err, ErrReadOnlyRole) {
+			t.Errorf("viewer: READ_ONLY_ROLE이어야 함. 실제: %v", err)
+		}
+		if _, err := as(service, testReporter).UpdateIssue(issue.ID, cmd, nil); !errors.Is(err, ErrReporterCreateOnly) {
+			t.Errorf("reporter: REPORTER_CREATE_ONLY여야 함. 실제: %v", err)
+		}
+		if _, err := as(service, testReporter).TransitionIssue(issue.ID, TransitionIssueCommand{Name: "block"}, nil); !errors.Is(err, ErrReporterCreateOnly) {
+			t.Errorf("reporter는 상태를 전이할 수 없어야 함. 실제: %v", err)
+		}
+		if after, _ := service.GetIssueByID(issue.ID); after.Version != issue.Version {
+			t.Errorf("거부된 변경은 저장되지 않아야 함: %+v", after)
+		}
+		if _, err := as(service, testMember).UpdateIssue(issue.ID, cmd, nil); err != nil {
+			t.Errorf("member는 이슈를 수정할 수 있어야 함: %v", err)
+		}
+	})
+}
+
+func TestCompleteIssue_담당자나_관리자만_완료(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		assigneeID := testMember.ID
+		byUpdate, _ := service.CreateIssue("담당 이슈", "설명", &assigneeID)
+		byTransition, _ := service.CreateIssue("담당 이슈", "설명", &assigneeID)
+		byAdmin, _ := service.CreateIssue("담당 이슈", "설명", &assigneeID)
+		other := &userModel.User{ID: 5, Name: "한동료", Role: userModel.RoleMember}
+
+		complete := model.NewUpdateCommand().WithStatus(model.StatusCompleted)
+		if _, err := as(service, other).UpdateIssue(byUpdate.ID, complete, nil); !errors.Is(err, ErrTransitionRequiresAssignee) {
+			t.Errorf("담당자가 아닌 member는 완료할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := as(service, other).TransitionIssue(byTransition.ID, TransitionIssueCommand{Name: "complete"}, nil); !errors.Is(err, ErrTransitionRequiresAssignee) {
+			t.Errorf("전이로도 완료할 수 없어야 함. 실제: %v", err)
+		}
+
+		if _, err := as(service, testMember).UpdateIssue(byUpdate.ID, complete, nil); err != nil {
+			t.Errorf("담당자는 완료할 수 있어야 함: %v", err)
+		}
+		if _, err := as(service, testMember).TransitionIssue(byTransition.ID, TransitionIssueCommand{Name: "complete"}, nil); err != nil {
+			t.Errorf("담당자는 전이로 완료할 수 있어야 함: %v", err)
+		}
+		if _, err := as(service, testAdmin).UpdateIssue(byAdmin.ID, complete, nil); err != nil {
+			t.Errorf("관리자는 완료할 수 있어야 함: %v", err)
+		}
+	})
+}
+
+func TestCancelIssue_관리자만_취소(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		assigneeID := testMember.ID
+		issue, _ := service.CreateIssue("담당 이슈", "설명", &assigneeID)
+
+		cancel := model.NewUpdateCommand().WithStatus(model.StatusCancelled)
+		if _, err := as(service, testMember).UpdateIssue(issue.ID, cancel, nil); !errors.Is(err, ErrTransitionRequiresAdmin) {
+			t.Errorf("담당자여도 member는 취소할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := as(service, testMember).TransitionIssue(issue.ID, TransitionIssueCommand{Name: "cancel"}, nil); !errors.Is(err, ErrTransitionRequiresAdmin) {
+			t.Errorf("전이로도 취소할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := as(service, testAdmin).TransitionIssue(issue.ID, TransitionIssueCommand{Name: "cancel"}, nil); err != nil {
+			t.Errorf("관리자는 취소할 수 있어야 함: %v", err)
+		}
+	})
+}
+
+// 상태 이름이 기본 워크플로와 달라도 전이에 붙은 permission으로 권한을 검사한다
+const customPermissionWorkflowYAML = `
+initial: {unassigned: OPEN, assigned: OPEN}
+states:
+  - name: OPEN
+  - name: DONE
+    terminal: true
+  - name: WONT_FIX
+    terminal: true
+transitions:
+  - {name: resolve, from: [OPEN], to: DONE, permission: assignee}
+  - {name: wont_fix, from: [OPEN], to: WONT_FIX, permission: admin}
+`
+
+func TestTransitionIssue_사용자_정의_워크플로의_전이_권한(t *testing.T) {
+	workflow, err := model.ParseWorkflow([]byte(customPermissionWorkflowYAML))
+	if err != nil {
+		t.Fatalf("워크플로 정의 오류: %v", err)
+	}
+	model.SetWorkflow(workflow)
+	t.Cleanup(func() { model.SetWorkflow(model.DefaultWorkflow()) })
+
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		assigneeID := testMember.ID
+		issue, _ := service.CreateIssue("담당 이슈", "설명", &assigneeID)
+		other := &userModel.User{ID: 5, Name: "한동료", Role: userModel.RoleMember}
+
+		if _, err := as(service, other).TransitionIssue(issue.ID, TransitionIssueCommand{Name: "resolve"}, nil); !errors.Is(err, ErrTransitionRequiresAssignee) {
+			t.Errorf("담당자가 아니면 resolve할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := as(service, testMember).UpdateIssue(issue.ID, model.NewUpdateCommand().WithStatus("WONT_FIX"), nil); !errors.Is(err, ErrTransitionRequiresAdmin) {
+			t.Errorf("담당자여도 member는 WONT_FIX로 바꿀 수 없어야 함. 실제: %v", err)
+		}
+		found, _ := as(service, testMember).GetIssueTransitions(issue.ID)
+		if len(found.Transitions) != 1 || found.Transitions[0].Name != "resolve" {
+			t.Errorf("담당자에게는 resolve만 보여야 함. 실제: %+v", found.Transitions)
+		}
+
+		resolved, err := as(service, testMember).UpdateIssue(issue.ID, model.NewUpdateCommand().WithStatus("DONE"), nil)
+		if err != nil || resolved.Status != "DONE" {
+			t.Errorf("담당자는 DONE으로 바꿀 수 있어야 함: %+v, %v", resolved, err)
+		}
+	})
+}
+
+func TestGetIssueTransitions_역할로_실행할_수_없는_전이는_제외(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		assigneeID := testMember.ID
+		issue, _ := service.CreateIssue("담당 이슈", "설명", &assigneeID)
+		other := &userModel.User{ID: 5, Name: "한동료", Role: userModel.RoleMember}
+
+		names := func(actor *userModel.User) []string {
+			found, err := as(service, actor).GetIssueTransitions(issue.ID)
+			if err != nil {
+				t.Fatalf("%s: 전이 목록을 조회할 수 있어야 함: %v", actor.Name, err)
+			}
+			var result []string
+			for _, transition := range found.Transitions {
+				result = append(result, transition.Name)
+			}
+			return result
+		}
+
+		admin := names(testAdmin)
+		if !slices.Contains(admin, "cancel") || !slices.Contains(admin, "complete") {
+			t.Errorf("관리자에게는 취소와 완료가 보여야 함. 실제: %v", admin)
+		}
+		if member := names(testMember); slices.Contains(member, "cancel") || !slices.Contains(member, "complete") {
+			t.Errorf("담당자에게는 완료만 보이고 취소는 보이지 않아야 함. 실제: %v", member)
+		}
+		if others := names(other); slices.Contains(others, "cancel") || slices.Contains(others, "complete") {
+			t.Errorf("담당자가 아닌 member에게는 완료와 취소가 보이지 않아야 함. 실제: %v", others)
+		}
+		for _, actor := range []*userModel.User{testReporter, testViewer} {
+			if found := names(actor); len(found) != 0 {
+				t.Errorf("%s: 전이할 수 없는 역할에게는 전이가 보이지 않아야 함. 실제: %v", actor.Role, found)
+			}
+		}
+	})
+}
+
+func TestDeleteIssue_관리자만_삭제와_복원(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("로그인 오류", "설명", nil)
+
+		if err := as(service, testMember).DeleteIssue(issue.ID, nil); !errors.Is(err, ErrAdminOnly) {
+			t.Errorf("member: ADMIN_ONLY여야 함. 실제: %v", err)
+		}
+		if err := as(service, testViewer).DeleteIssue(issue.ID, nil); !errors.Is(err, ErrReadOnlyRole) {
+			t.Errorf("viewer: READ_ONLY_ROLE이어야 함. 실제: %v", err)
+		}
+		if err := as(service, testAdmin).DeleteIssue(issue.ID, nil); err != nil {
+			t.Fatalf("관리자는 삭제할 수 있어야 함: %v", err)
+		}
+		if _, err := as(service, testMember).RestoreIssue(issue.ID, nil); !errors.Is(err, ErrAdminOnly) {
+			t.Errorf("member는 복원할 수 없어야 함. 실제: %v", err)
+		}
+		if err := as(service, testViewer).PurgeIssue(issue.ID); !errors.Is(err, ErrReadOnlyRole) {
+			t.Errorf("viewer는 완전 삭제할 수 없어야 함. 실제: %v", err)
+		}
+		if err := as(service, testMember).PurgeIssue(issue.ID); !errors.Is(err, ErrAdminOnly) {
+			t.Errorf("member는 완전 삭제할 수 없어야 함. 실제: %v", err)
+		}
+		if err := as(service, testAdmin).PurgeIssue(issue.ID); err != nil {
+			t.Errorf("관리자는 완전 삭제할 수 있어야 함: %v", err)
+		}
+	})
+}
+
+func TestCreateIssue_성공_등록자는_담당자가_바뀌어도_유지됨(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		assigneeID := uint(1)
+		issue, err := as(service, testReporter).CreateIssue("로그인 오류", "설명", &assigneeID)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if issue.Reporter == nil || issue.Reporter.ID != testReporter.ID || issue.User.ID != assigneeID {
+			t.Fatalf("등록자는 3번, 담당자는 1번이어야 함. 실제: %+v", issue)
+		}
+		service.CreateIssue("관리자가 등록", "", nil)
+
+		if _, err := as(service, testAdmin).UpdateIssue(issue.ID, model.NewUpdateCommand().WithUserID(2), nil); err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		after, _ := service.GetIssueByID(issue.ID)
+		if after.Reporter == nil || after.Reporter.ID != testReporter.ID || after.User.ID != 2 {
+			t.Errorf("담당자만 바뀌고 등록자는 그대로여야 함. 실제: %+v", after)
+		}
+
+		list, err := service.ListIssues(ListIssuesQuery{Filter: infrastructure.IssueFilter{ReporterIDs: []uint{testReporter.ID}}})
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if list.Total != 1 || list.Issues[0].ID != issue.ID {
+			t.Errorf("3번 사용자가 등록한 이슈 하나만 조회되어야 함. 실제: %+v", list.Issues)
+		}
+	})
+}
+
+func TestIssueService_실패_요청한_사용자가_없으면_변경_거부(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		assigneeID := uint(1)
+		issue, _ := service.CreateIssue("로그인 오류", "설명", &assigneeID)
+		anonymous := as(service, nil)
+
+		if _, err := anonymous.CreateIssue("로그인 오류", "설명", nil); !errors.Is(err, userApp.ErrAuthenticationRequired) {
+			t.Errorf("등록할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := anonymous.UpdateIssue(issue.ID, model.NewUpdateCommand().WithTitle("바꾼 제목"), nil); !errors.Is(err, userApp.ErrAuthenticationRequired) {
+			t.Errorf("수정할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := anonymous.TransitionIssue(issue.ID, TransitionIssueCommand{Name: "cancel"}, nil); !errors.Is(err, userApp.ErrAuthenticationRequired) {
+			t.Errorf("상태를 바꿀 수 없어야 함. 실제: %v", err)
+		}
+		if err := anonymous.DeleteIssue(issue.ID, nil); !errors.Is(err, userApp.ErrAuthenticationRequired) {
+			t.Errorf("삭제할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := service.HandOffAs(nil, "").HandOffIssues(1, nil); !errors.Is(err, userApp.ErrAuthenticationRequired) {
+			t.Errorf("이슈를 넘길 수 없어야 함. 실제: %v", err)
+		}
+		if found, err := anonymous.GetIssueTransitions(issue.ID); err != nil || len(found.Transitions) != 0 {
+			t.Errorf("실행할 수 있는 전이가 없어야 함. 실제: %+v, %v", found, err)
+		}
+
+		if after, _ := service.GetIssueByID(issue.ID); after.Version != issue.Version {
+			t.Errorf("이슈가 바뀌지 않아야 함. 실제: %+v", after)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+	userApp "issue-service-aoroa/user/application"
+)
+
+func TestReopenIssue_성공_기록이_저장되고_검색됨(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		userID := uint(1)
+		issue, _ := service.CreateIssue("로그인 버그", "", &userID)
+		service.CreateIssue("다른 이슈", "", nil)
+		completed, _ := service.TransitionIssue(issue.ID, TransitionIssueCommand{Name: "complete"}, nil)
+
+		reopened, err := as(service, testAdmin).ReopenIssue(issue.ID, ReopenIssueCommand{Reason: "회귀 발생"}, &completed.Issue.Version)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if reopened.Status != model.StatusInProgress || reopened.ReopenCount != 1 {
+			t.Errorf("진행중으로 다시 열려야 함. 실제: %+v", reopened)
+		}
+
+		stored, _ := service.GetIssueByID(issue.ID)
+		if stored.LastReopen == nil || stored.LastReopen.By.ID != testAdmin.ID || stored.LastReopen.Reason != "회귀 발생" {
+			t.Errorf("다시 연 기록이 저장되어야 함. 실제: %+v", stored.LastReopen)
+		}
+
+		found, err := service.SearchIssues(SearchIssuesQuery{Query: "reopens > 0"})
+		if err != nil || found.Total != 1 || found.Issues[0].ID != issue.ID {
+			t.Errorf("다시 연 이슈만 검색되어야 함. 실제: %+v, %v", found, err)
+		}
+	})
+}
+
+func TestReopenIssue_실패_인증된_사용자_없음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("이슈", "", nil)
+		service.TransitionIssue(issue.ID, TransitionIssueCommand{Name: "cancel"}, nil)
+
+		_, err := as(service, nil).ReopenIssue(issue.ID, ReopenIssueCommand{Reason: "사유"}, nil)
+		if !errors.Is(err, userApp.ErrAuthenticationRequired) {
+			t.Errorf("다시 연 사용자를 알 수 없으면 AUTHENTICATION_REQUIRED여야 함. 실제: %v", err)
+		}
+		if stored, _ := service.GetIssueByID(issue.ID); stored.ReopenCount != 0 {
+			t.Errorf("이슈가 다시 열리지 않아야 함. 실제: %+v", stored)
+		}
+	})
+}
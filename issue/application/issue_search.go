@@ -0,0 +1,49 @@
+package application
+
+import (
+	"time"
+
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/query"
+)
+
+// Query는 검색 쿼리 문자열이다. 정렬은 쿼리의 ORDER BY로 지정하며, 없으면 커서의 정렬이나 id 오름차순을 따른다.
+type SearchIssuesQuery struct {
+	Query          string
+	Limit          int
+	Cursor         string
+	IncludeDeleted bool
+}
+
+var querySortFields = map[query.Field]infrastructure.SortField{
+	query.FieldID:      infrastructure.SortByID,
+	query.FieldTitle:   infrastructure.SortByTitle,
+	query.FieldCreated: infrastructure.SortByCreatedAt,
+	query.FieldUpdated: infrastructure.SortByUpdatedAt,
+}
+
+func (s *issueService) SearchIssues(search SearchIssuesQuery) (*IssueList, error) {
+	parsed, err := query.Parse(search.Query, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var sort *infrastructure.SortOrder
+	if parsed.OrderBy != nil {
+		sort = &infrastructure.SortOrder{
+			Field:      querySortFields[parsed.OrderBy.Field],
+			Descending: parsed.OrderBy.Descending,
+		}
+	}
+
+	page, err := newPageRequest(search.Limit, search.Cursor, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.issueRepo.Search(parsed, search.IncludeDeleted, page)
+	if err != nil {
+		return nil, err
+	}
+	return newIssueList(result), nil
+}
@@ -0,0 +1,134 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/query"
+)
+
+func TestSearchIssues_성공_저장소와_무관하게_같은_결과(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		first, second := uint(1), uint(2)
+		service.CreateIssue("로그인 버그", "세션 만료", &first)
+		service.CreateIssue("결제 버그", "", &second)
+		service.CreateIssue("Login 화면 개선", "디자인 수정", nil)
+		service.CreateIssue("문서 정리", "로그인 가이드", nil)
+
+		tests := []struct {
+			query    string
+			expected []string
+		}{
+			{"assignee = 2", []string{"결제 버그"}},
+			{"assignee != 2", []string{"로그인 버그", "Login 화면 개선", "문서 정리"}},
+			{"assignee not in (1, 2)", []string{"Login 화면 개선", "문서 정리"}},
+			{"NOT assignee in (1)", []string{"결제 버그", "Login 화면 개선", "문서 정리"}},
+			{"assignee is empty AND title ~ login", []string{"Login 화면 개선"}},
+			{"title ~ 버그 OR description ~ 로그인 ORDER BY title DESC", []string{"문서 정리", "로그인 버그", "결제 버그"}},
+			{"description is empty", []string{"결제 버그"}},
+			{"status in (PENDING, IN_PROGRESS) AND created > -7d AND id >= 3", []string{"Login 화면 개선", "문서 정리"}},
+			{"created < -1d", nil},
+			{"title = '로그인 버그' OR (assignee is not empty AND NOT title !~ 결제)", []string{"로그인 버그", "결제 버그"}},
+		}
+
+		for _, tt := range tests {
+			list, err := service.SearchIssues(SearchIssuesQuery{Query: tt.query})
+			if err != nil {
+				t.Fatalf("%q: 에러가 발생하지 않아야 함: %v", tt.query, err)
+			}
+
+			var titles []string
+			for _, issue := range list.Issues {
+				titles = append(titles, issue.Title)
+			}
+			if len(titles) != len(tt.expected) || list.Total != len(tt.expected) {
+				t.Errorf("%q: 예상 %v, 실제 %v", tt.query, tt.expected, titles)
+				continue
+			}
+			for i := range titles {
+				if titles[i] != tt.expected[i] {
+					t.Errorf("%q: 예상 %v, 실제 %v", tt.query, tt.expected, titles)
+					break
+				}
+			}
+		}
+	})
+}
+
+func TestSearchIssues_성공_포함_연산자는_ASCII가_아닌_대소문자도_구분하지_않음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		service.CreateIssue("ÉCLAIR 화면 오류", "", nil)
+		service.CreateIssue("결제 버그", "Größe 계산 오류", nil)
+
+		tests := map[string]string{
+			"title ~ 'éclair'":    "ÉCLAIR 화면 오류",
+			"description ~ 'GRÖ'": "결제 버그",
+			"title !~ 'écLAIR'":   "결제 버그",
+		}
+		for q, expected := range tests {
+			list, err := service.SearchIssues(SearchIssuesQuery{Query: q})
+			if err != nil {
+				t.Fatalf("%q: 에러가 발생하지 않아야 함: %v", q, err)
+			}
+			if len(list.Issues) != 1 || list.Issues[0].Title != expected {
+				t.Errorf("%q: %s만 조회되어야 함. 실제: %+v", q, expected, list.Issues)
+			}
+		}
+	})
+}
+
+func TestSearchIssues_성공_ORDER_BY_정렬로_커서_페이지_순회(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		for _, title := range []string{"다", "가", "마", "나", "라"} {
+			service.CreateIssue(title, "", nil)
+		}
+
+		search := SearchIssuesQuery{Query: "status = PENDING ORDER BY title DESC", Limit: 2}
+		var titles []string
+		for {
+			list, err := service.SearchIssues(search)
+			if err != nil {
+				t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+			}
+			for _, issue := range list.Issues {
+				titles = append(titles, issue.Title)
+			}
+			if list.NextCursor == nil {
+				break
+			}
+			search.Cursor = *list.NextCursor
+		}
+
+		expected := []string{"마", "라", "다", "나", "가"}
+		if len(titles) != len(expected) {
+			t.Fatalf("모든 이슈가 한 번씩 조회되어야 함. 실제: %v", titles)
+		}
+		for i := range expected {
+			if titles[i] != expected[i] {
+				t.Fatalf("제목 내림차순이어야 함. 예상: %v, 실제: %v", expected, titles)
+			}
+		}
+	})
+}
+
+func TestSearchIssues_실패(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		service.CreateIssue("이슈1", "", nil)
+		service.CreateIssue("이슈2", "", nil)
+
+		_, err := service.SearchIssues(SearchIssuesQuery{Query: "status = PENDING AND"})
+		var syntaxErr *query.SyntaxError
+		if !errors.As(err, &syntaxErr) || syntaxErr.Position != 20 {
+			t.Errorf("쿼리 끝 위치의 구문 에러가 발생해야 함. 실제: %v", err)
+		}
+
+		first, _ := service.SearchIssues(SearchIssuesQuery{Query: "ORDER BY title", Limit: 1})
+		_, err = service.SearchIssues(SearchIssuesQuery{Query: "ORDER BY id", Limit: 1, Cursor: *first.NextCursor})
+		var validationErr *apperr.ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Field != "cursor" {
+			t.Errorf("정렬 기준이 다른 커서는 거부되어야 함. 실제: %v", err)
+		}
+	})
+}
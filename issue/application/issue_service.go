@@ -1,89 +1,294 @@
 package application
 
 import (
-	"errors"
+	"log"
+	"time"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
 	"issue-service-aoroa/issue/infrastructure"
 	"issue-service-aoroa/issue/model"
+	mentionApp "issue-service-aoroa/mention/application"
+	userApp "issue-service-aoroa/user/application"
 	userInfra "issue-service-aoroa/user/infrastructure"
 	userModel "issue-service-aoroa/user/model"
 )
 
 type IssueService interface {
 	CreateIssue(title, description string, userID *uint) (*model.Issue, error)
-	GetAllIssues() []model.Issue
+	GetAllIssues(includeDeleted bool) ([]model.Issue, error)
 	GetIssueByID(id uint) (*model.Issue, error)
-	UpdateIssue(id uint, updates map[string]interface{}) (*model.Issue, error)
-	GetIssuesByStatus(status string) ([]model.Issue, error)
+	GetIssueAsOf(id uint, asOf time.Time) (*model.Issue, error)
+	UpdateIssue(id uint, cmd *model.UpdateCommand, expectedVersion *uint) (*model.Issue, error)
+	PatchIssue(id uint, operations []PatchOperation, expectedVersion *uint) (*model.Issue, error)
+	GetIssuesByStatus(status string, includeDeleted bool) ([]model.Issue, error)
+	ListIssues(query ListIssuesQuery) (*IssueList, error)
+	SearchIssues(query SearchIssuesQuery) (*IssueList, error)
+	SearchIssuesByText(query TextSearchQuery) (*TextSearchResults, error)
+	DeleteIssue(id uint, expectedVersion *uint) error
+	RestoreIssue(id uint, expectedVersion *uint) (*model.Issue, error)
+	PurgeIssue(id uint) error
+	ReopenIssue(id uint, cmd ReopenIssueCommand, expectedVersion *uint) (*model.Issue, error)
+	GetIssueHistory(id uint) ([]model.HistoryEntry, error)
+	WithAudit(audit AuditContext) IssueService
+	GetIssueTransitions(id uint) (*IssueTransitions, error)
+	TransitionIssue(id uint, cmd TransitionIssueCommand, expectedVersion *uint) (*TransitionResult, error)
+	HandOffIssues(userID uint, successorID *uint) ([]model.Issue, error)
+	HandOffAs(actor *userModel.User, requestID string) userApp.IssueHandOff
+}
+
+// 패치 연산은 Test 또는 Command 중 하나만 가진다
+type PatchOperation struct {
+	Test    *model.Precondition
+	Command *model.UpdateCommand
 }
 
+// 종료된 이슈를 왜 다시 여는지 기록한다. 다시 연 사용자는 audit의 Actor다
+type ReopenIssueCommand struct {
+	Reason string
+}
+
+// commentRepo는 이슈를 완전히 삭제할 때 그 이슈의 댓글을 지우는 데만 쓴다
 type issueService struct {
-	issueRepo infrastructure.IssueRepository
-	userRepo  userInfra.UserRepository
+	issueRepo   infrastructure.IssueRepository
+	userRepo    userInfra.UserRepository
+	commentRepo commentInfra.CommentRepository
+	mentions    mentionApp.MentionService
+	audit       AuditContext
 }
 
-func NewIssueService(issueRepo infrastructure.IssueRepository, userRepo userInfra.UserRepository) IssueService {
+func NewIssueService(issueRepo infrastructure.IssueRepository, userRepo userInfra.UserRepository, commentRepo commentInfra.CommentRepository, mentions mentionApp.MentionService) IssueService {
 	return &issueService{
-		issueRepo: issueRepo,
-		userRepo:  userRepo,
+		issueRepo:   issueRepo,
+		userRepo:    userRepo,
+		commentRepo: commentRepo,
+		mentions:    mentions,
 	}
 }
 
 func (s *issueService) CreateIssue(title, description string, userID *uint) (*model.Issue, error) {
+	if err := authorizeCreate(s.audit.Actor); err != nil {
+		return nil, err
+	}
+
 	var assignee *userModel.User
 
 	if userID != nil {
-		user, err := s.findUserByID(*userID)
+		user, err := s.findAssignee(*userID)
 		if err != nil {
 			return nil, err
 		}
 		assignee = user
 	}
 
-	issue, err := model.NewIssue(title, description, assignee)
+	issue, err := model.NewReportedIssue(title, description, assignee, s.audit.Actor)
 	if err != nil {
 		return nil, err
 	}
 
-	createdIssue := s.issueRepo.Create(*issue)
+	history := s.audit.entries(model.HistoryCreated, nil, issue, "", time.Now())
+	createdIssue, err := s.issueRepo.Create(*issue, history)
+	if err != nil {
+		return nil, err
+	}
+	s.recordMentions(createdIssue)
 	return &createdIssue, nil
 }
 
-func (s *issueService) GetAllIssues() []model.Issue {
-	return s.issueRepo.GetAll()
+func (s *issueService) GetAllIssues(includeDeleted bool) ([]model.Issue, error) {
+	return s.issueRepo.GetAll(includeDeleted)
 }
 
 func (s *issueService) GetIssueByID(id uint) (*model.Issue, error) {
-	return s.issueRepo.GetByID(id)
+	return s.findIssueByID(id)
 }
 
-func (s *issueService) UpdateIssue(id uint, updates map[string]interface{}) (*model.Issue, error) {
+// 이벤트 로그 저장소에서만 지원한다. 그 시점에 아직 없던 이슈는 찾을 수 없는 이슈로 본다
+func (s *issueService) GetIssueAsOf(id uint, asOf time.Time) (*model.Issue, error) {
+	repo, ok := s.issueRepo.(infrastructure.TimeTravelRepository)
+	if !ok {
+		return nil, ErrTimeTravelUnsupported
+	}
+
+	issue, err := repo.GetByIDAsOf(id, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		return nil, ErrIssueNotFound
+	}
+	return issue, nil
+}
+
+func (s *issueService) UpdateIssue(id uint, cmd *model.UpdateCommand, expectedVersion *uint) (*model.Issue, error) {
+	return s.PatchIssue(id, []PatchOperation{{Command: cmd}}, expectedVersion)
+}
+
+// 연산을 순서대로 이슈 사본에 적용하고, 모두 성공했을 때만 한 번에 저장한다
+func (s *issueService) PatchIssue(id uint, operations []PatchOperation, expectedVersion *uint) (*model.Issue, error) {
 	existingIssue, err := s.findIssueByID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	updateCommand, err := s.buildUpdateCommand(updates)
+	if err := authorizeUpdate(s.audit.Actor); err != nil {
+		return nil, err
+	}
+	if err := checkVersion(existingIssue, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	before := *existingIssue
+	for _, operation := range operations {
+		if operation.Test != nil {
+			if err := operation.Test.Check(existingIssue); err != nil {
+				return nil, err
+			}
+		}
+		if operation.Command != nil {
+			if err := s.resolveAssignee(operation.Command); err != nil {
+				return nil, err
+			}
+			if err := operation.Command.ApplyTo(existingIssue); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := authorizeStatusChange(s.audit.Actor, &before, existingIssue); err != nil {
+		return nil, err
+	}
+
+	history := s.audit.entries(model.HistoryUpdated, &before, existingIssue, "", time.Now())
+	updatedIssue, err := s.issueRepo.Update(id, *existingIssue, history)
+	if err != nil {
+		return nil, err
+	}
+	if updatedIssue != nil && updatedIssue.Description != before.Description {
+		s.recordMentions(*updatedIssue)
+	}
+	return updatedIssue, nil
+}
+
+func (s *issueService) GetIssuesByStatus(status string, includeDeleted bool) ([]model.Issue, error) {
+	if !model.IsValidStatus(status) {
+		return nil, model.ErrInvalidStatus
+	}
+	return s.issueRepo.GetByStatus(status, includeDeleted)
+}
+
+func (s *issueService) DeleteIssue(id uint, expectedVersion *uint) error {
+	issue, err := s.findIssueByID(id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeAdmin(s.audit.Actor); err != nil {
+		return err
+	}
+	if err := checkVersion(issue, expectedVersion); err != nil {
+		return err
+	}
+
+	before, now := *issue, time.Now()
+	if err := issue.Delete(now); err != nil {
+		return err
+	}
+
+	_, err = s.issueRepo.Update(id, *issue, s.audit.entries(model.HistoryDeleted, &before, issue, "", now))
+	return err
+}
+
+func (s *issueService) RestoreIssue(id uint, expectedVersion *uint) (*model.Issue, error) {
+	issue, err := s.findIssueByID(id)
 	if err != nil {
 		return nil, err
 	}
+	if err := authorizeAdmin(s.audit.Actor); err != nil {
+		return nil, err
+	}
+	if err := checkVersion(issue, expectedVersion); err != nil {
+		return nil, err
+	}
 
-	if err := updateCommand.ApplyTo(existingIssue); err != nil {
+	before := *issue
+	if err := issue.Restore(); err != nil {
 		return nil, err
 	}
 
-	result, err := s.issueRepo.Update(id, *existingIssue)
+	return s.issueRepo.Update(id, *issue, s.audit.entries(model.HistoryRestored, &before, issue, "", time.Now()))
+}
+
+func (s *issueService) ReopenIssue(id uint, cmd ReopenIssueCommand, expectedVersion *uint) (*model.Issue, error) {
+	issue, err := s.findIssueByID(id)
 	if err != nil {
 		return nil, err
 	}
+	if err := authorizeAdmin(s.audit.Actor); err != nil {
+		return nil, err
+	}
+	if err := checkVersion(issue, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	if s.audit.Actor == nil {
+		return nil, userApp.ErrAuthenticationRequired
+	}
+
+	before, now := *issue, time.Now()
+	if err := issue.Reopen(*s.audit.Actor, cmd.Reason, now); err != nil {
+		return nil, err
+	}
 
-	return result, nil
+	history := s.audit.entries(model.HistoryReopened, &before, issue, issue.LastReopen.Reason, now)
+	return s.issueRepo.Update(id, *issue, history)
 }
 
-func (s *issueService) GetIssuesByStatus(status string) ([]model.Issue, error) {
-	if !model.IsValidStatus(status) {
-		return nil, errors.New("유효하지 않은 상태입니다")
+// 완전 삭제는 되돌릴 수 없으므로 먼저 삭제(soft delete)된 이슈만 지울 수 있다.
+// 멘션은 댓글을, 댓글은 이슈를 참조하므로 멘션, 댓글, 이슈 순서로 지운다. 도중에 실패해도 이슈는
+// 삭제된 상태로 남아 있으므로 다시 요청해 마저 지울 수 있다
+func (s *issueService) PurgeIssue(id uint) error {
+	issue, err := s.findIssueByID(id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeAdmin(s.audit.Actor); err != nil {
+		return err
+	}
+	if !issue.IsDeleted() {
+		return model.ErrIssueNotDeleted
+	}
+
+	if err := s.mentions.DeleteIssueMentions(id); err != nil {
+		return err
+	}
+	if err := s.commentRepo.DeleteByIssue(id); err != nil {
+		return err
+	}
+	purged, err := s.issueRepo.Purge(id)
+	if err != nil {
+		return err
+	}
+	if !purged {
+		return ErrIssueNotFound
+	}
+	return nil
+}
+
+// 멘션은 설명에서 다시 만들 수 있는 색인이다. 이슈는 이미 저장되었으므로
+// 기록하지 못해도 요청을 실패로 응답하지 않고 로그만 남긴다
+func (s *issueService) recordMentions(issue model.Issue) {
+	if err := s.mentions.RecordIssueMentions(issue); err != nil {
+		log.Printf("이슈 %d의 멘션을 기록하지 못했습니다: %v", issue.ID, err)
 	}
-	return s.issueRepo.GetByStatus(status), nil
+}
+
+func checkVersion(issue *model.Issue, expectedVersion *uint) error {
+	if expectedVersion != nil && *expectedVersion != issue.Version {
+		return &model.VersionConflictError{
+			IssueID:         issue.ID,
+			ExpectedVersion: *expectedVersion,
+			CurrentVersion:  issue.Version,
+		}
+	}
+	return nil
 }
 
 func (s *issueService) findIssueByID(id uint) (*model.Issue, error) {
@@ -92,7 +297,7 @@ func (s *issueService) findIssueByID(id uint) (*model.Issue, error) {
 		return nil, err
 	}
 	if issue == nil {
-		return nil, errors.New("이슈를 찾을 수 없습니다")
+		return nil, ErrIssueNotFound
 	}
 	return issue, nil
 }
@@ -103,44 +308,32 @@ func (s *issueService) findUserByID(userID uint) (*userModel.User, error) {
 		return nil, err
 	}
 	if user == nil {
-		return nil, errors.New("사용자를 찾을 수 없습니다")
+		return nil, ErrUserNotFound
 	}
 	return user, nil
 }
 
-func (s *issueService) buildUpdateCommand(updates map[string]interface{}) (*model.UpdateCommand, error) {
-	cmd := model.NewUpdateCommand()
-
-	if title, ok := updates["title"]; ok {
-		if titleStr, ok := title.(string); ok {
-			cmd.WithTitle(titleStr)
-		}
+func (s *issueService) resolveAssignee(cmd *model.UpdateCommand) error {
+	userID, ok := cmd.UserID.Value()
+	if !ok {
+		return nil
 	}
 
-	if description, ok := updates["description"]; ok {
-		if descStr, ok := description.(string); ok {
-			cmd.WithDescription(descStr)
-		}
+	user, err := s.findAssignee(userID)
+	if err != nil {
+		return err
 	}
+	cmd.User = user
+	return nil
+}
 
-	if status, ok := updates["status"]; ok {
-		if statusStr, ok := status.(string); ok {
-			cmd.WithStatus(statusStr)
-		}
+func (s *issueService) findAssignee(userID uint) (*userModel.User, error) {
+	user, err := s.findUserByID(userID)
+	if err != nil {
+		return nil, err
 	}
-
-	if userID, ok := updates["userId"]; ok {
-		if userID == nil {
-			cmd.WithoutUser()
-		} else if userIDFloat, ok := userID.(float64); ok {
-			userIDUint := uint(userIDFloat)
-			user, err := s.findUserByID(userIDUint)
-			if err != nil {
-				return nil, err
-			}
-			cmd.WithUser(userIDUint, user)
-		}
+	if !user.Active {
+		return nil, ErrAssigneeInactive
 	}
-
-	return cmd, nil
-}
\ No newline at end of file
+	return user, nil
+}
@@ -1,275 +1,301 @@
 package application
 
 import (
+	"errors"
+	"path/filepath"
 	"testing"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/database"
+	"issue-service-aoroa/database/migrations"
+	"issue-service-aoroa/issue/infrastructure"
 	"issue-service-aoroa/issue/model"
-	userModel "issue-service-aoroa/user/model"
+	mentionApp "issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	userInfra "issue-service-aoroa/user/infrastructure"
 )
 
-type mockIssueRepository struct {
-	issues []model.Issue
-	lastID uint
+type repositoryFactory struct {
+	name string
+	new  func(t *testing.T) (infrastructure.IssueRepository, userInfra.UserRepository)
 }
 
-func (m *mockIssueRepository) Create(issue model.Issue) model.Issue {
-	m.lastID++
-	issue.ID = m.lastID
-	m.issues = append(m.issues, issue)
-	return issue
+var repositoryFactories = []repositoryFactory{
+	{name: "memory", new: newMemoryRepositories},
+	{name: "sqlite", new: newSQLiteRepositories},
+	{name: "eventlog", new: newEventSourcedRepositories},
 }
 
-func (m *mockIssueRepository) GetAll() []model.Issue {
-	return m.issues
+func newMemoryRepositories(t *testing.T) (infrastructure.IssueRepository, userInfra.UserRepository) {
+	return infrastructure.NewIssueRepository(), userInfra.NewUserRepository()
 }
 
-func (m *mockIssueRepository) GetByID(id uint) (*model.Issue, error) {
-	for _, issue := range m.issues {
-		if issue.ID == id {
-			return &issue, nil
-		}
+func newSQLiteRepositories(t *testing.T) (infrastructure.IssueRepository, userInfra.UserRepository) {
+	db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("SQLite 연결 실패: %v", err)
 	}
-	return nil, nil
-}
+	t.Cleanup(func() { db.Close() })
 
-func (m *mockIssueRepository) Update(id uint, updatedIssue model.Issue) (*model.Issue, error) {
-	for i, issue := range m.issues {
-		if issue.ID == id {
-			updatedIssue.ID = id
-			m.issues[i] = updatedIssue
-			return &m.issues[i], nil
-		}
+	all, err := migrations.Load()
+	if err != nil {
+		t.Fatalf("마이그레이션 로드 실패: %v", err)
 	}
-	return nil, nil
-}
-
-func (m *mockIssueRepository) GetByStatus(status string) []model.Issue {
-	var filtered []model.Issue
-	for _, issue := range m.issues {
-		if issue.Status == status {
-			filtered = append(filtered, issue)
-		}
+	if _, err := migrations.NewMigrator(db, all).Up(); err != nil {
+		t.Fatalf("마이그레이션 적용 실패: %v", err)
 	}
-	return filtered
-}
 
-type mockUserRepository struct {
-	users []userModel.User
+	userRepo, err := userInfra.NewSQLiteUserRepository(db)
+	if err != nil {
+		t.Fatalf("사용자 저장소 생성 실패: %v", err)
+	}
+	issueRepo, err := infrastructure.NewSQLiteIssueRepository(db)
+	if err != nil {
+		t.Fatalf("이슈 저장소 생성 실패: %v", err)
+	}
+	return issueRepo, userRepo
 }
 
-func (m *mockUserRepository) GetByID(id uint) (*userModel.User, error) {
-	for _, user := range m.users {
-		if user.ID == id {
-			return &user, nil
-		}
+func newEventSourcedRepositories(t *testing.T) (infrastructure.IssueRepository, userInfra.UserRepository) {
+	log, err := infrastructure.OpenFileEventLog(filepath.Join(t.TempDir(), "events.log"))
+	if err != nil {
+		t.Fatalf("이벤트 로그 열기 실패: %v", err)
 	}
-	return nil, nil
-}
+	t.Cleanup(func() { log.Close() })
 
-func (m *mockUserRepository) GetAll() []userModel.User {
-	return m.users
+	issueRepo, err := infrastructure.NewEventSourcedIssueRepository(log)
+	if err != nil {
+		t.Fatalf("이슈 저장소 생성 실패: %v", err)
+	}
+	return issueRepo, userInfra.NewUserRepository()
 }
 
-func setupTestService() (IssueService, *mockIssueRepository, *mockUserRepository) {
-	issueRepo := &mockIssueRepository{
-		issues: []model.Issue{},
-		lastID: 0,
+// 모든 저장소 구현체에 대해 동일한 테스트를 실행한다
+func forEachRepository(t *testing.T, test func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository)) {
+	for _, factory := range repositoryFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			issueRepo, userRepo := factory.new(t)
+			mentions := mentionApp.NewMentionService(mentionInfra.NewMentionRepository(), issueRepo, userRepo)
+			test(t, as(NewIssueService(issueRepo, userRepo, commentInfra.NewCommentRepository(), mentions), testAdmin), issueRepo)
+		})
 	}
-	
-	userRepo := &mockUserRepository{
-		users: []userModel.User{
-			{ID: 1, Name: "김개발"},
-			{ID: 2, Name: "이디자인"},
-		},
-	}
-	
-	service := NewIssueService(issueRepo, userRepo)
-	return service, issueRepo, userRepo
 }
 
 func TestCreateIssue_실패_존재하지_않는_사용자(t *testing.T) {
-	service, _, _ := setupTestService()
-	
-	nonExistentUserID := uint(999)
-	_, err := service.CreateIssue("테스트 이슈", "설명", &nonExistentUserID)
-	
-	if err == nil {
-		t.Error("존재하지 않는 사용자로 이슈 생성 시 에러가 발생해야 함")
-	}
-	
-	expectedError := "사용자를 찾을 수 없습니다"
-	if err.Error() != expectedError {
-		t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
-	}
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		nonExistentUserID := uint(999)
+		_, err := service.CreateIssue("테스트 이슈", "설명", &nonExistentUserID)
+
+		if err == nil {
+			t.Error("존재하지 않는 사용자로 이슈 생성 시 에러가 발생해야 함")
+		}
+
+		expectedError := "사용자를 찾을 수 없습니다"
+		if err.Error() != expectedError {
+			t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
+		}
+	})
 }
 
 func TestCreateIssue_실패_빈_제목(t *testing.T) {
-	service, _, _ := setupTestService()
-	
-	_, err := service.CreateIssue("", "설명", nil)
-	
-	if err == nil {
-		t.Error("빈 제목으로 이슈 생성 시 에러가 발생해야 함")
-	}
-	
-	expectedError := "제목은 필수입니다"
-	if err.Error() != expectedError {
-		t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
-	}
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		_, err := service.CreateIssue("", "설명", nil)
+
+		if err == nil {
+			t.Error("빈 제목으로 이슈 생성 시 에러가 발생해야 함")
+		}
+
+		expectedError := "제목은 필수입니다"
+		if err.Error() != expectedError {
+			t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
+		}
+	})
 }
 
 func TestCreateIssue_성공_담당자_있음(t *testing.T) {
-	service, _, _ := setupTestService()
-	
-	userID := uint(1)
-	issue, err := service.CreateIssue("테스트 이슈", "설명", &userID)
-	
-	if err != nil {
-		t.Errorf("에러가 발생하지 않아야 함: %v", err)
-	}
-	
-	if issue.Status != model.StatusInProgress {
-		t.Errorf("담당자가 있는 이슈는 IN_PROGRESS 상태여야 함. 실제: %s", issue.Status)
-	}
-	
-	if issue.User == nil || issue.User.ID != userID {
-		t.Error("담당자가 올바르게 할당되어야 함")
-	}
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		userID := uint(1)
+		issue, err := service.CreateIssue("테스트 이슈", "설명", &userID)
+
+		if err != nil {
+			t.Errorf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		if issue.Status != model.StatusInProgress {
+			t.Errorf("담당자가 있는 이슈는 IN_PROGRESS 상태여야 함. 실제: %s", issue.Status)
+		}
+
+		if issue.User == nil || issue.User.ID != userID {
+			t.Error("담당자가 올바르게 할당되어야 함")
+		}
+	})
 }
 
 func TestUpdateIssue_실패_존재하지_않는_이슈(t *testing.T) {
-	service, _, _ := setupTestService()
-	
-	updates := map[string]interface{}{
-		"title": "새 제목",
-	}
-	
-	_, err := service.UpdateIssue(999, updates)
-	
-	if err == nil {
-		t.Error("존재하지 않는 이슈 업데이트 시 에러가 발생해야 함")
-	}
-	
-	expectedError := "이슈를 찾을 수 없습니다"
-	if err.Error() != expectedError {
-		t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
-	}
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		updates := model.NewUpdateCommand().WithTitle("새 제목")
+
+		_, err := service.UpdateIssue(999, updates, nil)
+
+		if err == nil {
+			t.Error("존재하지 않는 이슈 업데이트 시 에러가 발생해야 함")
+		}
+
+		expectedError := "이슈를 찾을 수 없습니다"
+		if err.Error() != expectedError {
+			t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
+		}
+	})
 }
 
 func TestUpdateIssue_실패_존재하지_않는_사용자_할당(t *testing.T) {
-	service, _, _ := setupTestService()
-	
-	// 먼저 이슈 생성
-	issue, _ := service.CreateIssue("테스트 이슈", "설명", nil)
-	
-	updates := map[string]interface{}{
-		"userId": float64(999), // JSON에서 숫자는 float64로 파싱됨
-	}
-	
-	_, err := service.UpdateIssue(issue.ID, updates)
-	
-	if err == nil {
-		t.Error("존재하지 않는 사용자 할당 시 에러가 발생해야 함")
-	}
-	
-	expectedError := "사용자를 찾을 수 없습니다"
-	if err.Error() != expectedError {
-		t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
-	}
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		// 먼저 이슈 생성
+		issue, _ := service.CreateIssue("테스트 이슈", "설명", nil)
+
+		updates := model.NewUpdateCommand().WithUserID(999)
+
+		_, err := service.UpdateIssue(issue.ID, updates, nil)
+
+		if err == nil {
+			t.Error("존재하지 않는 사용자 할당 시 에러가 발생해야 함")
+		}
+
+		expectedError := "사용자를 찾을 수 없습니다"
+		if err.Error() != expectedError {
+			t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
+		}
+	})
 }
 
 func TestUpdateIssue_실패_완료된_이슈_업데이트(t *testing.T) {
-	service, issueRepo, _ := setupTestService()
-	
-	// 완료된 이슈 생성
-	issue, _ := service.CreateIssue("테스트 이슈", "설명", nil)
-	// 직접 완료 상태로 변경 (테스트를 위해)
-	for i, iss := range issueRepo.issues {
-		if iss.ID == issue.ID {
-			issueRepo.issues[i].Status = model.StatusCompleted
-			break
+	forEachRepository(t, func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository) {
+		// 완료된 이슈 생성
+		issue, _ := service.CreateIssue("테스트 이슈", "설명", nil)
+		// 직접 완료 상태로 변경 (테스트를 위해)
+		issue.Status = model.StatusCompleted
+		if _, err := issueRepo.Update(issue.ID, *issue, nil); err != nil {
+			t.Fatalf("이슈 상태 변경 실패: %v", err)
 		}
-	}
-	
-	updates := map[string]interface{}{
-		"title": "새 제목",
-	}
-	
-	_, err := service.UpdateIssue(issue.ID, updates)
-	
-	if err == nil {
-		t.Error("완료된 이슈 업데이트 시 에러가 발생해야 함")
-	}
-	
-	expectedError := "완료되거나 취소된 이슈는 수정할 수 없습니다"
-	if err.Error() != expectedError {
-		t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
-	}
+
+		updates := model.NewUpdateCommand().WithTitle("새 제목")
+
+		_, err := service.UpdateIssue(issue.ID, updates, nil)
+
+		if err == nil {
+			t.Error("완료된 이슈 업데이트 시 에러가 발생해야 함")
+		}
+
+		expectedError := "완료되거나 취소된 이슈는 수정할 수 없습니다"
+		if err.Error() != expectedError {
+			t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
+		}
+	})
 }
 
 func TestGetIssuesByStatus_실패_유효하지_않은_상태(t *testing.T) {
-	service, _, _ := setupTestService()
-	
-	_, err := service.GetIssuesByStatus("INVALID_STATUS")
-	
-	if err == nil {
-		t.Error("유효하지 않은 상태로 필터링 시 에러가 발생해야 함")
-	}
-	
-	expectedError := "유효하지 않은 상태입니다"
-	if err.Error() != expectedError {
-		t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
-	}
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		_, err := service.GetIssuesByStatus("INVALID_STATUS", false)
+
+		if err == nil {
+			t.Error("유효하지 않은 상태로 필터링 시 에러가 발생해야 함")
+		}
+
+		expectedError := "유효하지 않은 상태입니다"
+		if err.Error() != expectedError {
+			t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
+		}
+	})
 }
 
 func TestUpdateIssue_성공_담당자_할당_후_상태_전환(t *testing.T) {
-	service, _, _ := setupTestService()
-	
-	// PENDING 상태의 이슈 생성
-	issue, _ := service.CreateIssue("테스트 이슈", "설명", nil)
-	
-	// 담당자 할당
-	updates := map[string]interface{}{
-		"userId": float64(1),
-	}
-	
-	updatedIssue, err := service.UpdateIssue(issue.ID, updates)
-	
-	if err != nil {
-		t.Errorf("에러가 발생하지 않아야 함: %v", err)
-	}
-	
-	if updatedIssue.Status != model.StatusInProgress {
-		t.Errorf("담당자 할당 후 IN_PROGRESS 상태로 전환되어야 함. 실제: %s", updatedIssue.Status)
-	}
-	
-	if updatedIssue.User == nil || updatedIssue.User.ID != 1 {
-		t.Error("담당자가 올바르게 할당되어야 함")
-	}
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		// PENDING 상태의 이슈 생성
+		issue, _ := service.CreateIssue("테스트 이슈", "설명", nil)
+
+		// 담당자 할당
+		updates := model.NewUpdateCommand().WithUserID(1)
+
+		updatedIssue, err := service.UpdateIssue(issue.ID, updates, nil)
+
+		if err != nil {
+			t.Errorf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		if updatedIssue.Status != model.StatusInProgress {
+			t.Errorf("담당자 할당 후 IN_PROGRESS 상태로 전환되어야 함. 실제: %s", updatedIssue.Status)
+		}
+
+		if updatedIssue.User == nil || updatedIssue.User.ID != 1 {
+			t.Error("담당자가 올바르게 할당되어야 함")
+		}
+	})
 }
 
 func TestUpdateIssue_성공_담당자_제거_후_PENDING_전환(t *testing.T) {
-	service, _, _ := setupTestService()
-	
-	// 담당자가 있는 이슈 생성
-	userID := uint(1)
-	issue, _ := service.CreateIssue("테스트 이슈", "설명", &userID)
-	
-	// 담당자 제거
-	updates := map[string]interface{}{
-		"userId": nil,
-	}
-	
-	updatedIssue, err := service.UpdateIssue(issue.ID, updates)
-	
-	if err != nil {
-		t.Errorf("에러가 발생하지 않아야 함: %v", err)
-	}
-	
-	if updatedIssue.Status != model.StatusPending {
-		t.Errorf("담당자 제거 후 PENDING 상태로 전환되어야 함. 실제: %s", updatedIssue.Status)
-	}
-	
-	if updatedIssue.User != nil {
-		t.Error("담당자가 제거되어야 함")
-	}
-}
\ No newline at end of file
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		// 담당자가 있는 이슈 생성
+		userID := uint(1)
+		issue, _ := service.CreateIssue("테스트 이슈", "설명", &userID)
+
+		// 담당자 제거
+		updates := model.NewUpdateCommand().WithoutUser()
+
+		updatedIssue, err := service.UpdateIssue(issue.ID, updates, nil)
+
+		if err != nil {
+			t.Errorf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		if updatedIssue.Status != model.StatusPending {
+			t.Errorf("담당자 제거 후 PENDING 상태로 전환되어야 함. 실제: %s", updatedIssue.Status)
+		}
+
+		if updatedIssue.User != nil {
+			t.Error("담당자가 제거되어야 함")
+		}
+	})
+}
+
+func TestUpdateIssue_실패_요청_버전_불일치(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("테스트 이슈", "설명", nil)
+		service.UpdateIssue(issue.ID, model.NewUpdateCommand().WithTitle("첫 수정"), nil)
+
+		staleVersion := issue.Version
+		_, err := service.UpdateIssue(issue.ID, model.NewUpdateCommand().WithTitle("두번째 수정"), &staleVersion)
+
+		var conflictErr *model.VersionConflictError
+		if !errors.As(err, &conflictErr) {
+			t.Fatalf("버전 충돌 에러가 발생해야 함. 실제: %v", err)
+		}
+		if conflictErr.CurrentVersion != staleVersion+1 {
+			t.Errorf("현재 버전이 %d여야 함. 실제: %d", staleVersion+1, conflictErr.CurrentVersion)
+		}
+	})
+}
+
+func TestIssueRepository_Update_실패_오래된_버전으로_저장(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, issueRepo infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("테스트 이슈", "설명", nil)
+
+		first := *issue
+		first.Title = "먼저 저장"
+		updated, err := issueRepo.Update(issue.ID, first, nil)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if updated.Version != issue.Version+1 {
+			t.Errorf("저장 후 버전이 증가해야 함. 예상: %d, 실제: %d", issue.Version+1, updated.Version)
+		}
+
+		second := *issue
+		second.Title = "나중에 저장"
+		_, err = issueRepo.Update(issue.ID, second, nil)
+
+		var conflictErr *model.VersionConflictError
+		if !errors.As(err, &conflictErr) {
+			t.Errorf("오래된 버전으로 저장 시 버전 충돌 에러가 발생해야 함. 실제: %v", err)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package application
+
+import (
+	"strings"
+	"time"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/model"
+	"issue-service-aoroa/issue/query"
+	"issue-service-aoroa/issue/search"
+)
+
+// Text는 전문 검색어이고, Query를 함께 주면 검색 쿼리의 조건으로 결과를 한 번 더 거른다.
+// 결과는 관련도순이므로 Query에 ORDER BY를 쓸 수 없다.
+type TextSearchQuery struct {
+	Text           string
+	Query          string
+	Limit          int
+	IncludeDeleted bool
+}
+
+// Highlights는 검색어가 나온 필드별 발췌문이며, 일치한 부분은 <em></em>으로 감싼다
+type TextSearchResult struct {
+	Issue      model.Issue
+	Score      float64
+	Highlights map[string]string
+}
+
+type TextSearchResults struct {
+	Results []TextSearchResult
+	Total   int
+}
+
+func (s *issueService) SearchIssuesByText(textQuery TextSearchQuery) (*TextSearchResults, error) {
+	if strings.TrimSpace(textQuery.Text) == "" {
+		return nil, &apperr.ValidationError{Field: "text", Message: "검색어는 필수입니다"}
+	}
+
+	limit, err := pageLimit(textQuery.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := query.Parse(textQuery.Query, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if parsed.OrderBy != nil {
+		return nil, &apperr.ValidationError{Field: "q", Reason: "ORDER_BY_WITH_TEXT_SEARCH", Message: "전문 검색 결과는 관련도순으로 정렬되므로 ORDER BY를 쓸 수 없습니다"}
+	}
+
+	hits, err := s.issueRepo.SearchText(textQuery.Text, textQuery.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := search.QueryTerms(textQuery.Text)
+	results := &TextSearchResults{Results: []TextSearchResult{}}
+	for _, hit := range hits {
+		if !parsed.Matches(hit.Issue) {
+			continue
+		}
+		results.Total++
+		if len(results.Results) < limit {
+			results.Results = append(results.Results, TextSearchResult{
+				Issue:      hit.Issue,
+				Score:      hit.Score,
+				Highlights: highlights(hit.Issue, terms),
+			})
+		}
+	}
+	return results, nil
+}
+
+func highlights(issue model.Issue, terms []string) map[string]string {
+	result := map[string]string{}
+	if snippet, ok := search.Highlight(issue.Title, terms); ok {
+		result[string(search.FieldTitle)] = snippet
+	}
+	if snippet, ok := search.Highlight(issue.Description, terms); ok {
+		result[string(search.FieldDescription)] = snippet
+	}
+	return result
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+)
+
+func resultTitles(results *TextSearchResults) []string {
+	var titles []string
+	for _, result := range results.Results {
+		titles = append(titles, result.Issue.Title)
+	}
+	return titles
+}
+
+func TestSearchIssuesByText_성공_관련도순_결과와_발췌문(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		service.CreateIssue("화면 개선", "로그인 버튼 위치 조정", nil)
+		service.CreateIssue("로그인오류", "세션이 만료되면 로그인 페이지로 이동하지 않음", nil)
+		service.CreateIssue("결제 오류", "", nil)
+
+		results, err := service.SearchIssuesByText(TextSearchQuery{Text: "로그인"})
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		titles := resultTitles(results)
+		if results.Total != 2 || len(titles) != 2 || titles[0] != "로그인오류" || titles[1] != "화면 개선" {
+			t.Fatalf("제목에 검색어가 있는 이슈가 먼저여야 함. 실제: %v", titles)
+		}
+		highlights := results.Results[0].Highlights
+		if highlights["title"] != "<em>로그인</em>오류" {
+			t.Errorf("제목 발췌문이 강조되어야 함. 실제: %q", highlights["title"])
+		}
+		if highlights["description"] != "세션이 만료되면 <em>로그인</em> 페이지로 이동하지 않음" {
+			t.Errorf("설명 발췌문이 강조되어야 함. 실제: %q", highlights["description"])
+		}
+		if _, ok := results.Results[1].Highlights["title"]; ok {
+			t.Errorf("일치하지 않는 필드는 발췌문이 없어야 함. 실제: %v", results.Results[1].Highlights)
+		}
+	})
+}
+
+func TestSearchIssuesByText_성공_수정하면_색인이_갱신됨(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		issue, _ := service.CreateIssue("로그인 버그", "", nil)
+
+		if _, err := service.UpdateIssue(issue.ID, model.NewUpdateCommand().WithTitle("결제 버그"), nil); err != nil {
+			t.Fatalf("수정 실패: %v", err)
+		}
+
+		if results, _ := service.SearchIssuesByText(TextSearchQuery{Text: "로그인"}); results.Total != 0 {
+			t.Errorf("이전 제목으로는 검색되지 않아야 함. 실제: %v", resultTitles(results))
+		}
+		if results, _ := service.SearchIssuesByText(TextSearchQuery{Text: "결제"}); results.Total != 1 {
+			t.Errorf("새 제목으로 검색되어야 함. 실제: %v", resultTitles(results))
+		}
+	})
+}
+
+func TestSearchIssuesByText_성공_삭제된_이슈는_관련도_계산에서도_빠짐(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		service.CreateIssue("로그인 버그", "", nil)
+		service.CreateIssue("결제 오류", "", nil)
+		before, _ := service.SearchIssuesByText(TextSearchQuery{Text: "로그인"})
+
+		deleted, _ := service.CreateIssue("로그인 오류", "", nil)
+		if err := service.DeleteIssue(deleted.ID, nil); err != nil {
+			t.Fatalf("삭제 실패: %v", err)
+		}
+
+		after, err := service.SearchIssuesByText(TextSearchQuery{Text: "로그인"})
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if after.Total != 1 || after.Results[0].Score != before.Results[0].Score {
+			t.Errorf("삭제된 이슈가 관련도에 영향을 주지 않아야 함. 이전: %v, 이후: %v", before.Results[0].Score, after.Results[0].Score)
+		}
+		if withDeleted, _ := service.SearchIssuesByText(TextSearchQuery{Text: "로그인", IncludeDeleted: true}); withDeleted.Total != 2 {
+			t.Errorf("삭제 포함 검색이면 삭제된 이슈도 나와야 함. 실제: %v", resultTitles(withDeleted))
+		}
+	})
+}
+
+func TestSearchIssuesByText_성공_검색_쿼리로_거르고_limit만큼_반환(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		userID := uint(1)
+		service.CreateIssue("버그 1", "", &userID)
+		service.CreateIssue("버그 2", "", nil)
+		service.CreateIssue("버그 3", "", nil)
+
+		results, err := service.SearchIssuesByText(TextSearchQuery{Text: "버그", Query: "assignee is empty", Limit: 1})
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if results.Total != 2 || len(results.Results) != 1 || results.Results[0].Issue.Title != "버그 2" {
+			t.Errorf("미할당 이슈 2건 중 1건만 반환되어야 함. 실제: %d건, %v", results.Total, resultTitles(results))
+		}
+	})
+}
+
+func TestSearchIssuesByText_실패(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		tests := []struct {
+			query  TextSearchQuery
+			field  string
+			reason string
+		}{
+			{TextSearchQuery{Text: "  "}, "text", ""},
+			{TextSearchQuery{Text: "버그", Query: "ORDER BY title"}, "q", "ORDER_BY_WITH_TEXT_SEARCH"},
+			{TextSearchQuery{Text: "버그", Limit: 101}, "limit", ""},
+		}
+
+		for _, tt := range tests {
+			_, err := service.SearchIssuesByText(tt.query)
+			var validationErr *apperr.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != tt.field || validationErr.Reason != tt.reason {
+				t.Errorf("%+v: %s %s 검증 에러가 발생해야 함. 실제: %v", tt.query, tt.field, tt.reason, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package application
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/model"
+)
+
+const maxTransitionCommentLength = 1000
+
+type IssueTransitions struct {
+	Issue       *model.Issue
+	Transitions []model.Transition
+}
+
+type TransitionIssueCommand struct {
+	Name    string
+	Comment string
+}
+
+type TransitionResult struct {
+	Issue      *model.Issue
+	Transition model.Transition
+	From       string
+	Comment    string
+}
+
+// 이슈의 현재 상태에서 실행할 수 있는 전이 목록. 담당자 조건을 만족하지 못하는 전이와
+// 요청한 사용자의 역할로는 실행할 수 없는 전이는 제외한다
+func (s *issueService) GetIssueTransitions(id uint) (*IssueTransitions, error) {
+	issue, err := s.findIssueByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssueTransitions{
+		Issue:       issue,
+		Transitions: s.permittedTransitions(issue),
+	}, nil
+}
+
+func (s *issueService) permittedTransitions(issue *model.Issue) []model.Transition {
+	if authorizeUpdate(s.audit.Actor) != nil {
+		return nil
+	}
+
+	var permitted []model.Transition
+	for _, transition := range issue.AvailableTransitions() {
+		if authorizeTransition(s.audit.Actor, issue, transition) == nil {
+			permitted = append(permitted, transition)
+		}
+	}
+	return permitted
+}
+
+func (s *issueService) TransitionIssue(id uint, cmd TransitionIssueCommand, expectedVersion *uint) (*TransitionResult, error) {
+	comment := strings.TrimSpace(cmd.Comment)
+	if utf8.RuneCountInString(comment) > maxTransitionCommentLength {
+		return nil, &apperr.ValidationError{Field: "comment", Message: "코멘트는 1000자 이하여야 합니다"}
+	}
+
+	issue, err := s.findIssueByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeUpdate(s.audit.Actor); err != nil {
+		return nil, err
+	}
+	if err := checkVersion(issue, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	before := *issue
+	transition, err := issue.ApplyTransition(cmd.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTransition(s.audit.Actor, &before, transition); err != nil {
+		return nil, err
+	}
+
+	history := s.audit.entries(model.HistoryTransitioned, &before, issue, comment, time.Now())
+	updated, err := s.issueRepo.Update(id, *issue, history)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransitionResult{
+		Issue:      updated,
+		Transition: transition,
+		From:       before.Status,
+		Comment:    comment,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+)
+
+func TestTransitionIssue_성공_상태가_저장됨(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		userID := uint(1)
+		issue, _ := service.CreateIssue("이슈", "", &userID)
+
+		result, err := service.TransitionIssue(issue.ID, TransitionIssueCommand{Name: "block", Comment: "  API 대기  "}, &issue.Version)
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if result.From != model.StatusInProgress || result.Issue.Status != model.StatusBlocked || result.Comment != "API 대기" {
+			t.Errorf("전이 결과가 다름. 실제: %+v", result)
+		}
+
+		stored, _ := service.GetIssueByID(issue.ID)
+		if stored.Status != model.StatusBlocked || stored.Version != issue.Version+1 {
+			t.Errorf("전이 결과가 저장되어야 함. 실제: %+v", stored)
+		}
+	})
+}
+
+func TestGetIssueTransitions_종료되거나_삭제된_이슈는_없음(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service IssueService, _ infrastructure.IssueRepository) {
+		cancelled, _ := service.CreateIssue("취소할 이슈", "", nil)
+		service.TransitionIssue(cancelled.ID, TransitionIssueCommand{Name: "cancel"}, nil)
+		deleted, _ := service.CreateIssue("삭제할 이슈", "", nil)
+		service.DeleteIssue(deleted.ID, nil)
+
+		for _, id := range []uint{cancelled.ID, deleted.ID} {
+			found, err := service.GetIssueTransitions(id)
+			if err != nil || len(found.Transitions) != 0 {
+				t.Errorf("이슈 %d: 가능한 전이가 없어야 함. 실제: %+v, %v", id, found, err)
+			}
+		}
+
+		_, err := service.TransitionIssue(deleted.ID, TransitionIssueCommand{Name: "cancel"}, nil)
+		if !errors.Is(err, model.ErrIssueDeleted) {
+			t.Errorf("삭제된 이슈 에러가 발생해야 함. 실제: %v", err)
+		}
+	})
+}
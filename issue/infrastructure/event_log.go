@@ -0,0 +1,199 @@
+package infrastructure
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	issueModel "issue-service-aoroa/issue/model"
+)
+
+// 이슈 하나에 대한 한 번의 저장. 같은 저장에서 발생한 이벤트와 변경 이력을 함께 담아
+// 로그 한 줄로 기록하므로, 저장은 전부 남거나 전부 남지 않는다
+type EventLogEntry struct {
+	Sequence uint64
+	IssueID  uint
+	Version  uint
+	At       time.Time
+	Events   []issueModel.Event
+	History  []issueModel.HistoryEntry
+}
+
+// 이벤트 로그는 추가만 할 수 있다. Append는 Sequence를 매겨 기록한 항목을 반환한다
+type EventLog interface {
+	ReadAll() ([]EventLogEntry, error)
+	Append(entry EventLogEntry) (EventLogEntry, error)
+	AppendAll(entries []EventLogEntry) ([]EventLogEntry, error)
+}
+
+type storedEvent struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type storedEntry struct {
+	Sequence uint64                    `json:"sequence"`
+	IssueID  uint                      `json:"issueId"`
+	Version  uint                      `json:"version"`
+	At       time.Time                 `json:"at"`
+	Events   []storedEvent             `json:"events"`
+	History  []issueModel.HistoryEntry `json:"history,omitempty"`
+}
+
+func (e EventLogEntry) MarshalJSON() ([]byte, error) {
+	stored := storedEntry{
+		Sequence: e.Sequence,
+		IssueID:  e.IssueID,
+		Version:  e.Version,
+		At:       e.At,
+		Events:   make([]storedEvent, 0, len(e.Events)),
+		History:  e.History,
+	}
+	for _, event := range e.Events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		stored.Events = append(stored.Events, storedEvent{Type: event.EventType(), Data: data})
+	}
+	return json.Marshal(stored)
+}
+
+func (e *EventLogEntry) UnmarshalJSON(data []byte) error {
+	var stored storedEntry
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	events := make([]issueModel.Event, 0, len(stored.Events))
+	for _, s := range stored.Events {
+		event, err := issueModel.NewEvent(s.Type)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(s.Data, event); err != nil {
+			return fmt.Errorf("%s 이벤트 해석 실패: %w", s.Type, err)
+		}
+		events = append(events, event)
+	}
+
+	*e = EventLogEntry{
+		Sequence: stored.Sequence,
+		IssueID:  stored.IssueID,
+		Version:  stored.Version,
+		At:       stored.At,
+		Events:   events,
+		History:  stored.History,
+	}
+	return nil
+}
+
+// 항목을 JSON 한 줄씩 기록하는 파일 로그. 기록할 때마다 디스크에 동기화한다
+type FileEventLog struct {
+	mu           sync.Mutex
+	file         *os.File
+	lastSequence uint64
+}
+
+func OpenFileEventLog(path string) (*FileEventLog, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("이벤트 로그 열기 실패: %w", err)
+	}
+	return &FileEventLog{file: file}, nil
+}
+
+// 기록 도중 중단되어 줄바꿈 없이 끝난 마지막 줄은 저장되지 않은 것으로 보고 잘라 낸다.
+// 그 외에 해석할 수 없는 줄이 있으면 에러를 반환한다
+func (l *FileEventLog) ReadAll() ([]EventLogEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var entries []EventLogEntry
+	var offset int64
+	reader := bufio.NewReader(l.file)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				if err := l.file.Truncate(offset); err != nil {
+					return nil, fmt.Errorf("이벤트 로그의 불완전한 마지막 줄 정리 실패: %w", err)
+				}
+			}
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		offset += int64(len(line))
+
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry EventLogEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("이벤트 로그 %d번째 줄 해석 실패: %w", lineNumber, err)
+		}
+		entries = append(entries, entry)
+		l.lastSequence = max(l.lastSequence, entry.Sequence)
+	}
+	return entries, nil
+}
+
+func (l *FileEventLog) Append(entry EventLogEntry) (EventLogEntry, error) {
+	appended, err := l.AppendAll([]EventLogEntry{entry})
+	if err != nil {
+		return EventLogEntry{}, err
+	}
+	return appended[0], nil
+}
+
+// 여러 항목을 한 번의 쓰기와 동기화로 기록한다. 쓰기에 실패하면 어느 항목도 남기지 않는다
+func (l *FileEventLog) AppendAll(entries []EventLogEntry) ([]EventLogEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	appended := make([]EventLogEntry, 0, len(entries))
+	var lines []byte
+	for i, entry := range entries {
+		entry.Sequence = l.lastSequence + uint64(i) + 1
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(append(lines, line...), '\n')
+		appended = append(appended, entry)
+	}
+
+	info, err := l.file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := l.file.Write(lines); err != nil {
+		// 일부만 기록된 줄이 다음 항목과 이어지지 않도록 기록 전 크기로 되돌린다
+		l.file.Truncate(info.Size())
+		return nil, fmt.Errorf("이벤트 로그 기록 실패: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return nil, fmt.Errorf("이벤트 로그 동기화 실패: %w", err)
+	}
+
+	if len(appended) > 0 {
+		l.lastSequence = appended[len(appended)-1].Sequence
+	}
+	return appended, nil
+}
+
+func (l *FileEventLog) Close() error {
+	return l.file.Close()
+}
@@ -0,0 +1,108 @@
+package infrastructure
+
+import (
+	"strings"
+	"time"
+
+	issueModel "issue-service-aoroa/issue/model"
+)
+
+// 이슈 목록 조회 조건. 설정된 조건은 모두 만족해야 하며(AND),
+// 한 조건 안의 여러 값은 그중 하나만 만족하면 된다(OR). 비어 있는 조건은 무시한다.
+type IssueFilter struct {
+	Statuses []string
+
+	// AssigneeIDs 중 한 명에게 할당되었거나, Unassigned가 true이면 담당자가 없는 이슈
+	AssigneeIDs []uint
+	Unassigned  bool
+
+	// ReporterIDs 중 한 명이 등록한 이슈
+	ReporterIDs []uint
+
+	// From은 포함, To는 제외한다
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+
+	// 제목 또는 설명에 포함된 문자열 (대소문자 구분 없음)
+	Keyword string
+
+	// 삭제된 이슈는 true일 때만 포함한다
+	IncludeDeleted bool
+}
+
+func (f IssueFilter) Matches(issue issueModel.Issue) bool {
+	return (f.IncludeDeleted || !issue.IsDeleted()) &&
+		f.matchesStatus(issue) &&
+		f.matchesAssignee(issue) &&
+		f.matchesReporter(issue) &&
+		inRange(issue.CreatedAt, f.CreatedFrom, f.CreatedTo) &&
+		inRange(issue.UpdatedAt, f.UpdatedFrom, f.UpdatedTo) &&
+		f.matchesKeyword(issue)
+}
+
+func (f IssueFilter) hasAssigneeCondition() bool {
+	return len(f.AssigneeIDs) > 0 || f.Unassigned
+}
+
+func (f IssueFilter) matchesStatus(issue issueModel.Issue) bool {
+	if len(f.Statuses) == 0 {
+		return true
+	}
+	for _, status := range f.Statuses {
+		if issue.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (f IssueFilter) matchesAssignee(issue issueModel.Issue) bool {
+	if !f.hasAssigneeCondition() {
+		return true
+	}
+	if issue.User == nil {
+		return f.Unassigned
+	}
+	for _, id := range f.AssigneeIDs {
+		if issue.User.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (f IssueFilter) matchesReporter(issue issueModel.Issue) bool {
+	if len(f.ReporterIDs) == 0 {
+		return true
+	}
+	if issue.Reporter == nil {
+		return false
+	}
+	for _, id := range f.ReporterIDs {
+		if issue.Reporter.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (f IssueFilter) matchesKeyword(issue issueModel.Issue) bool {
+	if f.Keyword == "" {
+		return true
+	}
+	keyword := strings.ToLower(f.Keyword)
+	return strings.Contains(strings.ToLower(issue.Title), keyword) ||
+		strings.Contains(strings.ToLower(issue.Description), keyword)
+}
+
+func inRange(t time.Time, from, to *time.Time) bool {
+	if from != nil && t.Before(*from) {
+		return false
+	}
+	if to != nil && !t.Before(*to) {
+		return false
+	}
+	return true
+}
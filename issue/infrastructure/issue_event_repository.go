@@ -0,0 +1,230 @@
+package infrastructure
+
+import (
+	"fmt"
+	"time"
+
+	issueModel "issue-service-aoroa/issue/model"
+)
+
+// 특정 시점의 이슈 상태를 재구성할 수 있는 저장소. 이벤트 로그 저장소만 지원한다.
+// 그 시점에 이슈가 없었거나 완전히 삭제된 이슈이면 nil을 반환한다
+type TimeTravelRepository interface {
+	GetByIDAsOf(id uint, asOf time.Time) (*issueModel.Issue, error)
+}
+
+// 이벤트 로그를 원본으로 두고, 조회는 로그를 재생해 만든 메모리 저장소(프로젝션)에서 한다.
+// 쓰기는 로그에 먼저 기록한 뒤 같은 잠금 안에서 프로젝션에 적용하므로 로그 순서와 적용 순서가 같다
+type eventSourcedIssueRepository struct {
+	*issueRepository
+	log     EventLog
+	entries map[uint][]EventLogEntry
+}
+
+// 로그 전체를 재생해 프로젝션을 다시 만든다. 로그가 손상되었으면 에러를 반환한다
+func NewEventSourcedIssueRepository(log EventLog) (IssueRepository, error) {
+	r := &eventSourcedIssueRepository{
+		issueRepository: newIssueRepository(),
+		log:             log,
+		entries:         map[uint][]EventLogEntry{},
+	}
+
+	entries, err := log.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if err := r.apply(entry); err != nil {
+			return nil, fmt.Errorf("이벤트 로그 재생 실패(sequence %d): %w", entry.Sequence, err)
+		}
+	}
+	return r, nil
+}
+
+// NewIssue로 만든 이슈는 생성 이벤트를 갖고 있다. 그렇지 않은 이슈는 현재 필드로 생성 이벤트를 만든다
+func (r *eventSourcedIssueRepository) Create(issue issueModel.Issue, history []issueModel.HistoryEntry) (issueModel.Issue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := issue.Events()
+	if len(events) == 0 || events[0].EventType() != issueModel.EventIssueCreated {
+		events = []issueModel.Event{issueModel.IssueCreated{
+			Title:       issue.Title,
+			Description: issue.Description,
+			Status:      issue.Status,
+			Assignee:    issue.User,
+			Reporter:    issue.Reporter,
+		}}
+	}
+
+	id := r.lastID + 1
+	if err := r.record(EventLogEntry{IssueID: id, Version: 1, Events: events, History: history}); err != nil {
+		return issueModel.Issue{}, err
+	}
+	return cloneIssue(*r.findIssue(id)), nil
+}
+
+// 이슈 메서드가 남긴 이벤트를 기록한다. 필드를 직접 바꿔 이벤트로 설명되지 않는 차이가 있으면
+// 그 차이를 이벤트로 보충해, 로그를 재생한 결과가 저장하려던 상태와 같게 한다
+func (r *eventSourcedIssueRepository) Update(id uint, updatedIssue issueModel.Issue, history []issueModel.HistoryEntry) (*issueModel.Issue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.findIssue(id)
+	if current == nil {
+		return nil, nil
+	}
+	if updatedIssue.Version != current.Version {
+		return nil, &issueModel.VersionConflictError{
+			IssueID:         id,
+			ExpectedVersion: updatedIssue.Version,
+			CurrentVersion:  current.Version,
+		}
+	}
+
+	if err := r.record(updateEntry(*current, updatedIssue, history)); err != nil {
+		return nil, err
+	}
+	result := cloneIssue(*r.findIssue(id))
+	return &result, nil
+}
+
+// 모든 이슈의 항목을 로그에 한 번에 기록한다
+func (r *eventSourcedIssueRepository) UpdateAll(updates []IssueUpdate) ([]issueModel.Issue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := make([]EventLogEntry, 0, len(updates))
+	now := time.Now()
+	for _, update := range updates {
+		if err := r.checkVersion(update.Issue); err != nil {
+			return nil, err
+		}
+		entry := updateEntry(*r.findIssue(update.Issue.ID), update.Issue, update.History)
+		entry.At = now
+		entries = append(entries, entry)
+	}
+
+	recorded, err := r.log.AppendAll(entries)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]issueModel.Issue, 0, len(recorded))
+	for _, entry := range recorded {
+		if err := r.apply(entry); err != nil {
+			return nil, err
+		}
+		results = append(results, cloneIssue(*r.findIssue(entry.IssueID)))
+	}
+	return results, nil
+}
+
+// 로그에는 삭제 기록만 추가된다. 이후로는 과거 시점 조회로도 이슈를 볼 수 없다
+func (r *eventSourcedIssueRepository) Purge(id uint) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.findIssue(id)
+	if current == nil {
+		return false, nil
+	}
+
+	entry := EventLogEntry{
+		IssueID: id,
+		Version: current.Version + 1,
+		Events:  []issueModel.Event{issueModel.IssuePurged{}},
+	}
+	if err := r.record(entry); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// asOf 이전에 기록된 항목만 재생한다
+func (r *eventSourcedIssueRepository) GetByIDAsOf(id uint, asOf time.Time) (*issueModel.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var issue issueModel.Issue
+	applied := false
+	for _, entry := range r.entries[id] {
+		if entry.At.After(asOf) {
+			break
+		}
+		applyEntry(&issue, entry)
+		applied = true
+	}
+	if !applied {
+		return nil, nil
+	}
+
+	result := cloneIssue(issue)
+	return &result, nil
+}
+
+// 아래 메서드는 호출하는 쪽에서 쓰기 잠금을 잡고 있어야 한다
+
+func (r *eventSourcedIssueRepository) record(entry EventLogEntry) error {
+	entry.At = time.Now()
+	recorded, err := r.log.Append(entry)
+	if err != nil {
+		return err
+	}
+	return r.apply(recorded)
+}
+
+func (r *eventSourcedIssueRepository) apply(entry EventLogEntry) error {
+	if isPurge(entry) {
+		r.removeIssue(entry.IssueID)
+		delete(r.entries, entry.IssueID)
+		return nil
+	}
+
+	var issue issueModel.Issue
+	if current := r.findIssue(entry.IssueID); current != nil {
+		if entry.Version != current.Version+1 {
+			return fmt.Errorf("이슈 %d의 버전이 이어지지 않습니다: 현재 %d, 기록 %d", entry.IssueID, current.Version, entry.Version)
+		}
+		issue = cloneIssue(*current)
+	} else if entry.Version != 1 {
+		return fmt.Errorf("이슈 %d의 생성 기록이 없습니다", entry.IssueID)
+	}
+
+	applyEntry(&issue, entry)
+	r.putIssue(issue)
+	r.appendHistory(entry.IssueID, entry.History)
+	r.entries[entry.IssueID] = append(r.entries[entry.IssueID], entry)
+	return nil
+}
+
+// 이슈 메서드가 남긴 이벤트에, 필드를 직접 바꿔 생긴 차이를 보충한 항목을 만든다
+func updateEntry(current, updated issueModel.Issue, history []issueModel.HistoryEntry) EventLogEntry {
+	events := updated.Events()
+	replayed := cloneIssue(current)
+	for _, event := range events {
+		event.Apply(&replayed)
+	}
+	events = append(events, issueModel.EventsBetween(&replayed, &updated)...)
+	return EventLogEntry{IssueID: current.ID, Version: current.Version + 1, Events: events, History: history}
+}
+
+func applyEntry(issue *issueModel.Issue, entry EventLogEntry) {
+	for _, event := range entry.Events {
+		event.Apply(issue)
+	}
+	issue.ID = entry.IssueID
+	issue.Version = entry.Version
+	issue.UpdatedAt = entry.At
+	if entry.Version == 1 {
+		issue.CreatedAt = entry.At
+	}
+}
+
+func isPurge(entry EventLogEntry) bool {
+	for _, event := range entry.Events {
+		if event.EventType() == issueModel.EventIssuePurged {
+			return true
+		}
+	}
+	return false
+}
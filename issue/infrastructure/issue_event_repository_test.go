@@ -0,0 +1,164 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	issueModel "issue-service-aoroa/issue/model"
+	userModel "issue-service-aoroa/user/model"
+)
+
+func openEventRepository(t *testing.T, path string) IssueRepository {
+	t.Helper()
+	repo, err := NewEventSourcedIssueRepository(mustOpenEventLog(t, path))
+	if err != nil {
+		t.Fatalf("이슈 저장소 생성 실패: %v", err)
+	}
+	return repo
+}
+
+func createAndAssign(t *testing.T, repo IssueRepository) issueModel.Issue {
+	t.Helper()
+	issue, _ := issueModel.NewIssue("로그인 오류", "설명", nil)
+	created, err := repo.Create(*issue, []issueModel.HistoryEntry{{Action: issueModel.HistoryCreated, Field: "title"}})
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+
+	created.AssignTo(&userModel.User{ID: 1, Name: "김개발"})
+	updated, err := repo.Update(created.ID, created, nil)
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	return *updated
+}
+
+func TestEventSourcedIssueRepository_재시작하면_로그로_다시_구성(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	repo := openEventRepository(t, path)
+
+	assigned := createAndAssign(t, repo)
+	title := "로그인 오류 수정"
+	assigned.UpdateDetails(&title, nil)
+	assigned.Delete(time.Now())
+	if _, err := repo.Update(assigned.ID, assigned, nil); err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	purged, _ := repo.Create(issueModel.Issue{Title: "지울 이슈", Status: issueModel.StatusPending}, nil)
+	repo.Purge(purged.ID)
+
+	rebuilt := openEventRepository(t, path)
+
+	issue, _ := rebuilt.GetByID(assigned.ID)
+	if issue == nil {
+		t.Fatal("재구성한 저장소에서 이슈를 찾을 수 있어야 함")
+	}
+	if issue.Title != title || issue.Status != issueModel.StatusInProgress || issue.User == nil || issue.User.ID != 1 {
+		t.Errorf("재구성한 상태가 다름: %+v", issue)
+	}
+	if issue.Version != 3 || !issue.IsDeleted() {
+		t.Errorf("버전 3의 삭제된 이슈여야 함. 실제: 버전 %d, 삭제 %v", issue.Version, issue.IsDeleted())
+	}
+	if history, _ := rebuilt.History(assigned.ID); len(history) != 1 {
+		t.Errorf("변경 이력도 재구성되어야 함. 실제: %d건", len(history))
+	}
+	if gone, _ := rebuilt.GetByID(purged.ID); gone != nil {
+		t.Error("완전히 삭제한 이슈는 재구성되지 않아야 함")
+	}
+
+	next, _ := rebuilt.Create(issueModel.Issue{Title: "새 이슈", Status: issueModel.StatusPending}, nil)
+	if next.ID != purged.ID+1 {
+		t.Errorf("완전히 삭제한 이슈의 ID를 다시 쓰지 않아야 함. 실제: %d", next.ID)
+	}
+}
+
+func TestEventSourcedIssueRepository_직접_바꾼_필드도_기록(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	repo := openEventRepository(t, path)
+
+	created, _ := repo.Create(issueModel.Issue{Title: "이슈", Status: issueModel.StatusPending}, nil)
+	created.Status = issueModel.StatusCompleted
+	repo.Update(created.ID, created, nil)
+
+	issue, _ := openEventRepository(t, path).GetByID(created.ID)
+	if issue.Status != issueModel.StatusCompleted {
+		t.Errorf("이벤트 없이 바꾼 상태도 재구성되어야 함. 실제: %s", issue.Status)
+	}
+}
+
+func TestEventSourcedIssueRepository_GetByIDAsOf(t *testing.T) {
+	repo := openEventRepository(t, filepath.Join(t.TempDir(), "events.log"))
+	beforeCreate := time.Now()
+	time.Sleep(time.Millisecond)
+
+	assigned := createAndAssign(t, repo)
+	time.Sleep(time.Millisecond)
+	afterAssign := time.Now()
+	time.Sleep(time.Millisecond)
+
+	assigned.ChangeStatus(issueModel.StatusCompleted)
+	repo.Update(assigned.ID, assigned, nil)
+
+	travel := repo.(TimeTravelRepository)
+	if issue, _ := travel.GetByIDAsOf(assigned.ID, beforeCreate); issue != nil {
+		t.Error("생성 전 시점에는 이슈가 없어야 함")
+	}
+
+	issue, err := travel.GetByIDAsOf(assigned.ID, afterAssign)
+	if err != nil || issue == nil {
+		t.Fatalf("할당 직후 시점의 이슈를 찾을 수 있어야 함: %v", err)
+	}
+	if issue.Status != issueModel.StatusInProgress || issue.Version != 2 || issue.User == nil {
+		t.Errorf("할당 직후 상태여야 함. 실제: %s, 버전 %d", issue.Status, issue.Version)
+	}
+
+	latest, _ := travel.GetByIDAsOf(assigned.ID, time.Now())
+	if latest.Status != issueModel.StatusCompleted {
+		t.Errorf("현재 시점은 최신 상태여야 함. 실제: %s", latest.Status)
+	}
+}
+
+func TestFileEventLog_불완전한_마지막_줄은_잘라냄(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	repo := openEventRepository(t, path)
+	createAndAssign(t, repo)
+
+	file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	file.WriteString(`{"sequence":3,"issueId":1,"version":3,"ev`)
+	file.Close()
+
+	rebuilt := openEventRepository(t, path)
+	issue, _ := rebuilt.GetByID(1)
+	if issue == nil || issue.Version != 2 {
+		t.Fatalf("완전히 기록된 항목까지만 재생해야 함: %+v", issue)
+	}
+
+	issue.Unassign()
+	if _, err := rebuilt.Update(issue.ID, *issue, nil); err != nil {
+		t.Fatalf("잘라 낸 뒤에도 기록할 수 있어야 함: %v", err)
+	}
+	if _, err := NewEventSourcedIssueRepository(mustOpenEventLog(t, path)); err != nil {
+		t.Errorf("다시 열어도 로그가 손상되지 않아야 함: %v", err)
+	}
+}
+
+func TestFileEventLog_실패_손상된_줄(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	os.WriteFile(path, []byte("{\"sequence\":1,\"issueId\":1,\"version\":1,\"events\":[{\"type\":\"Unknown\",\"data\":{}}]}\n"), 0o644)
+
+	if _, err := NewEventSourcedIssueRepository(mustOpenEventLog(t, path)); err == nil {
+		t.Error("해석할 수 없는 항목이 있으면 에러가 발생해야 함")
+	}
+}
+
+func mustOpenEventLog(t *testing.T, path string) *FileEventLog {
+	t.Helper()
+	log, err := OpenFileEventLog(path)
+	if err != nil {
+		t.Fatalf("이벤트 로그 열기 실패: %v", err)
+	}
+	t.Cleanup(func() { log.Close() })
+	return log
+}
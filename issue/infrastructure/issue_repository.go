@@ -1,71 +1,371 @@
 package infrastructure
 
 import (
+	"slices"
+	"sort"
+	"sync"
 	"time"
+
 	issueModel "issue-service-aoroa/issue/model"
+	"issue-service-aoroa/issue/query"
+	"issue-service-aoroa/issue/search"
 )
 
+// GetByID는 삭제된 이슈도 반환하고, 목록과 검색은 includeDeleted가 false이면 삭제된 이슈를 제외한다.
+// Purge는 이슈를 변경 이력과 함께 저장소에서 완전히 지우며, 지운 이슈가 없으면 false를 반환한다.
+// 이슈에 딸린 댓글과 멘션은 이슈 서비스가 각 도메인의 저장소에서 먼저 지운다.
+// Create와 Update는 이슈와 변경 이력을 함께 저장하고, 둘 중 하나라도 실패하면 아무것도 저장하지 않는다.
+// UpdateAll은 여러 이슈를 한 번에 저장하며, 버전이 맞지 않거나 그사이 지워진 이슈가 하나라도 있으면
+// VersionConflictError를 반환하고 아무것도 저장하지 않는다.
 type IssueRepository interface {
-	Create(issue issueModel.Issue) issueModel.Issue
-	GetAll() []issueModel.Issue
+	Create(issue issueModel.Issue, history []issueModel.HistoryEntry) (issueModel.Issue, error)
+	GetAll(includeDeleted bool) ([]issueModel.Issue, error)
 	GetByID(id uint) (*issueModel.Issue, error)
-	Update(id uint, issue issueModel.Issue) (*issueModel.Issue, error)
-	GetByStatus(status string) []issueModel.Issue
+	Update(id uint, issue issueModel.Issue, history []issueModel.HistoryEntry) (*issueModel.Issue, error)
+	UpdateAll(updates []IssueUpdate) ([]issueModel.Issue, error)
+	GetByStatus(status string, includeDeleted bool) ([]issueModel.Issue, error)
+	Find(filter IssueFilter, page PageRequest) (IssuePage, error)
+	Search(q *query.Query, includeDeleted bool, page PageRequest) (IssuePage, error)
+	SearchText(text string, includeDeleted bool) ([]TextHit, error)
+	Purge(id uint) (bool, error)
+	History(issueID uint) ([]issueModel.HistoryEntry, error)
+}
+
+// UpdateAll로 함께 저장할 이슈와 그 변경 이력
+type IssueUpdate struct {
+	Issue   issueModel.Issue
+	History []issueModel.HistoryEntry
 }
 
+// 전문 검색 결과. 관련도가 높은 순으로 정렬된다.
+type TextHit struct {
+	Issue issueModel.Issue
+	Score float64
+}
+
+// 저장된 이슈는 항상 복사본으로 주고받아 호출자가 내부 상태를 변경할 수 없게 한다.
+// 전문 검색 색인은 이슈를 저장할 때 같은 잠금 안에서 갱신한다.
 type issueRepository struct {
-	issues []issueModel.Issue
-	lastID uint
+	mu            sync.RWMutex
+	issues        []issueModel.Issue
+	lastID        uint
+	index         *search.Index
+	history       []issueModel.HistoryEntry
+	lastHistoryID uint
 }
 
 func NewIssueRepository() IssueRepository {
+	return newIssueRepository()
+}
+
+func newIssueRepository() *issueRepository {
 	return &issueRepository{
 		issues: []issueModel.Issue{},
 		lastID: 0,
+		index:  search.NewIndex(),
 	}
 }
 
-func (r *issueRepository) Create(issue issueModel.Issue) issueModel.Issue {
+func (r *issueRepository) Create(issue issueModel.Issue, history []issueModel.HistoryEntry) (issueModel.Issue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	r.lastID++
+	issue = cloneIssue(issue)
 	issue.ID = r.lastID
+	issue.Version = 1
 	issue.CreatedAt = time.Now()
-	issue.UpdatedAt = time.Now()
+	issue.UpdatedAt = issue.CreatedAt
 	r.issues = append(r.issues, issue)
-	return issue
+	r.index.Put(issue.ID, issue.Title, issue.Description)
+	r.appendHistory(issue.ID, history)
+	return cloneIssue(issue), nil
 }
 
-func (r *issueRepository) GetAll() []issueModel.Issue {
-	return r.issues
+func (r *issueRepository) GetAll(includeDeleted bool) ([]issueModel.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	issues := make([]issueModel.Issue, 0, len(r.issues))
+	for _, issue := range r.issues {
+		if includeDeleted || !issue.IsDeleted() {
+			issues = append(issues, cloneIssue(issue))
+		}
+	}
+	return issues, nil
 }
 
 func (r *issueRepository) GetByID(id uint) (*issueModel.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	for _, issue := range r.issues {
 		if issue.ID == id {
-			return &issue, nil
+			found := cloneIssue(issue)
+			return &found, nil
 		}
 	}
 	return nil, nil
 }
 
-func (r *issueRepository) Update(id uint, updatedIssue issueModel.Issue) (*issueModel.Issue, error) {
+func (r *issueRepository) Update(id uint, updatedIssue issueModel.Issue, history []issueModel.HistoryEntry) (*issueModel.Issue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	for i, issue := range r.issues {
 		if issue.ID == id {
+			if updatedIssue.Version != issue.Version {
+				return nil, &issueModel.VersionConflictError{
+					IssueID:         id,
+					ExpectedVersion: updatedIssue.Version,
+					CurrentVersion:  issue.Version,
+				}
+			}
+
+			updatedIssue = cloneIssue(updatedIssue)
 			updatedIssue.ID = id
+			updatedIssue.Version = issue.Version + 1
 			updatedIssue.CreatedAt = issue.CreatedAt
 			updatedIssue.UpdatedAt = time.Now()
 			r.issues[i] = updatedIssue
-			return &r.issues[i], nil
+			r.index.Put(id, updatedIssue.Title, updatedIssue.Description)
+			r.appendHistory(id, history)
+
+			result := cloneIssue(updatedIssue)
+			return &result, nil
 		}
 	}
 	return nil, nil
 }
 
-func (r *issueRepository) GetByStatus(status string) []issueModel.Issue {
+func (r *issueRepository) UpdateAll(updates []IssueUpdate) ([]issueModel.Issue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, update := range updates {
+		if err := r.checkVersion(update.Issue); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]issueModel.Issue, 0, len(updates))
+	for _, update := range updates {
+		updated := cloneIssue(update.Issue)
+		current := r.findIssue(updated.ID)
+		updated.Version = current.Version + 1
+		updated.CreatedAt = current.CreatedAt
+		updated.UpdatedAt = time.Now()
+		r.putIssue(updated)
+		r.appendHistory(updated.ID, update.History)
+		results = append(results, cloneIssue(updated))
+	}
+	return results, nil
+}
+
+func (r *issueRepository) GetByStatus(status string, includeDeleted bool) ([]issueModel.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	var filtered []issueModel.Issue
 	for _, issue := range r.issues {
-		if issue.Status == status {
-			filtered = append(filtered, issue)
+		if issue.Status == status && (includeDeleted || !issue.IsDeleted()) {
+			filtered = append(filtered, cloneIssue(issue))
+		}
+	}
+	return filtered, nil
+}
+
+func (r *issueRepository) Find(filter IssueFilter, page PageRequest) (IssuePage, error) {
+	return r.findMatching(filter.Matches, page)
+}
+
+func (r *issueRepository) Search(q *query.Query, includeDeleted bool, page PageRequest) (IssuePage, error) {
+	return r.findMatching(func(issue issueModel.Issue) bool {
+		return (includeDeleted || !issue.IsDeleted()) && q.Matches(issue)
+	}, page)
+}
+
+func (r *issueRepository) findMatching(matches func(issueModel.Issue) bool, page PageRequest) (IssuePage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []issueModel.Issue
+	for _, issue := range r.issues {
+		if matches(issue) {
+			matched = append(matched, issue)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return compareIssues(matched[i], matched[j], page.Sort) < 0
+	})
+
+	start := 0
+	if page.After != nil {
+		after, err := cursorIssue(page.After)
+		if err != nil {
+			return IssuePage{}, ErrInvalidCursor
+		}
+		start = sort.Search(len(matched), func(i int) bool {
+			return compareIssues(matched[i], after, page.Sort) > 0
+		})
+	}
+
+	end := min(start+page.Limit, len(matched))
+	result := IssuePage{
+		Issues: make([]issueModel.Issue, 0, end-start),
+		Total:  len(matched),
+	}
+	for _, issue := range matched[start:end] {
+		result.Issues = append(result.Issues, cloneIssue(issue))
+	}
+	if end < len(matched) && end > start {
+		result.NextCursor = cursorOf(matched[end-1], page.Sort)
+	}
+	return result, nil
+}
+
+func (r *issueRepository) SearchText(text string, includeDeleted bool) ([]TextHit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	byID := make(map[uint]issueModel.Issue, len(r.issues))
+	for _, issue := range r.issues {
+		byID[issue.ID] = issue
+	}
+
+	hits := r.index.SearchWhere(text, func(id uint) bool {
+		issue := byID[id]
+		return includeDeleted || !issue.IsDeleted()
+	})
+	result := make([]TextHit, 0, len(hits))
+	for _, hit := range hits {
+		issue := byID[hit.DocumentID]
+		result = append(result, TextHit{Issue: cloneIssue(issue), Score: hit.Score})
+	}
+	return result, nil
+}
+
+func (r *issueRepository) Purge(id uint) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.removeIssue(id), nil
+}
+
+func (r *issueRepository) History(issueID uint) ([]issueModel.HistoryEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := []issueModel.HistoryEntry{}
+	for _, entry := range r.history {
+		if entry.IssueID == issueID {
+			entries = append(entries, cloneHistoryEntry(entry))
+		}
+	}
+	return entries, nil
+}
+
+// 아래 메서드는 호출하는 쪽에서 잠금을 잡고 있어야 한다
+
+func (r *issueRepository) findIssue(id uint) *issueModel.Issue {
+	for i := range r.issues {
+		if r.issues[i].ID == id {
+			return &r.issues[i]
+		}
+	}
+	return nil
+}
+
+// 지워진 이슈는 현재 버전을 0으로 보고 충돌로 처리한다
+func (r *issueRepository) checkVersion(issue issueModel.Issue) error {
+	var currentVersion uint
+	if current := r.findIssue(issue.ID); current != nil {
+		currentVersion = current.Version
+	}
+	if issue.Version != currentVersion {
+		return &issueModel.VersionConflictError{
+			IssueID:         issue.ID,
+			ExpectedVersion: issue.Version,
+			CurrentVersion:  currentVersion,
+		}
+	}
+	return nil
+}
+
+// 같은 ID의 이슈가 있으면 교체하고, 없으면 끝에 추가한다
+func (r *issueRepository) putIssue(issue issueModel.Issue) {
+	issue = cloneIssue(issue)
+	r.index.Put(issue.ID, issue.Title, issue.Description)
+	if existing := r.findIssue(issue.ID); existing != nil {
+		*existing = issue
+		return
+	}
+	r.issues = append(r.issues, issue)
+	r.lastID = max(r.lastID, issue.ID)
+}
+
+func (r *issueRepository) removeIssue(id uint) bool {
+	for i, issue := range r.issues {
+		if issue.ID == id {
+			r.issues = append(r.issues[:i], r.issues[i+1:]...)
+			r.index.Remove(id)
+			r.history = slices.DeleteFunc(r.history, func(entry issueModel.HistoryEntry) bool {
+				return entry.IssueID == id
+			})
+			return true
 		}
 	}
-	return filtered
-}
\ No newline at end of file
+	return false
+}
+
+func (r *issueRepository) appendHistory(issueID uint, history []issueModel.HistoryEntry) {
+	for _, entry := range history {
+		r.lastHistoryID++
+		entry = cloneHistoryEntry(entry)
+		entry.ID = r.lastHistoryID
+		entry.IssueID = issueID
+		r.history = append(r.history, entry)
+	}
+}
+
+func cloneHistoryEntry(entry issueModel.HistoryEntry) issueModel.HistoryEntry {
+	entry.OldValue = cloneString(entry.OldValue)
+	entry.NewValue = cloneString(entry.NewValue)
+	if entry.Actor != nil {
+		actor := *entry.Actor
+		entry.Actor = &actor
+	}
+	return entry
+}
+
+func cloneString(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	copied := *value
+	return &copied
+}
+
+// 저장소를 거친 이슈는 아직 저장되지 않은 이벤트를 갖지 않는다
+func cloneIssue(issue issueModel.Issue) issueModel.Issue {
+	issue.ClearEvents()
+	if issue.User != nil {
+		user := *issue.User
+		issue.User = &user
+	}
+	if issue.Reporter != nil {
+		reporter := *issue.Reporter
+		issue.Reporter = &reporter
+	}
+	if issue.DeletedAt != nil {
+		deletedAt := *issue.DeletedAt
+		issue.DeletedAt = &deletedAt
+	}
+	if issue.LastReopen != nil {
+		reopen := *issue.LastReopen
+		issue.LastReopen = &reopen
+	}
+	return issue
+}
+
@@ -0,0 +1,87 @@
+package infrastructure
+
+import (
+	"sync"
+	"testing"
+
+	issueModel "issue-service-aoroa/issue/model"
+	userModel "issue-service-aoroa/user/model"
+)
+
+// go test -race 로 실행해 데이터 경합이 없는지 확인한다
+func TestIssueRepository_동시_생성_수정_조회(t *testing.T) {
+	repo := NewIssueRepository()
+
+	const workers = 16
+	const perWorker = 50
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				created, err := repo.Create(issueModel.Issue{Title: "이슈", Status: issueModel.StatusPending}, nil)
+				if err != nil {
+					t.Errorf("에러가 발생하지 않아야 함: %v", err)
+					return
+				}
+
+				created.Title = "수정된 이슈"
+				created.User = &userModel.User{ID: 1, Name: "김개발"}
+				if _, err := repo.Update(created.ID, created, nil); err != nil {
+					t.Errorf("에러가 발생하지 않아야 함: %v", err)
+					return
+				}
+
+				repo.GetAll(false)
+				repo.GetByID(created.ID)
+				repo.GetByStatus(issueModel.StatusPending, false)
+			}
+		}()
+	}
+	wg.Wait()
+
+	issues, _ := repo.GetAll(false)
+	if len(issues) != workers*perWorker {
+		t.Fatalf("생성된 이슈 수가 일치해야 함. 예상: %d, 실제: %d", workers*perWorker, len(issues))
+	}
+
+	seen := make(map[uint]bool)
+	for _, issue := range issues {
+		if seen[issue.ID] {
+			t.Fatalf("이슈 ID가 중복되면 안 됨: %d", issue.ID)
+		}
+		seen[issue.ID] = true
+	}
+}
+
+func TestIssueRepository_GetAll_결과_변경이_저장소에_반영되지_않음(t *testing.T) {
+	repo := NewIssueRepository()
+	repo.Create(issueModel.Issue{Title: "원래 제목", User: &userModel.User{ID: 1, Name: "김개발"}}, nil)
+
+	issues, _ := repo.GetAll(false)
+	issues[0].Title = "변경된 제목"
+	issues[0].User.Name = "변경된 이름"
+
+	stored, _ := repo.GetByID(issues[0].ID)
+	if stored.Title != "원래 제목" {
+		t.Errorf("조회 결과 변경이 저장소에 반영되면 안 됨. 실제 제목: %s", stored.Title)
+	}
+	if stored.User.Name != "김개발" {
+		t.Errorf("담당자 정보 변경이 저장소에 반영되면 안 됨. 실제 이름: %s", stored.User.Name)
+	}
+}
+
+func TestIssueRepository_Update_반환값_변경이_저장소에_반영되지_않음(t *testing.T) {
+	repo := NewIssueRepository()
+	created, _ := repo.Create(issueModel.Issue{Title: "원래 제목"}, nil)
+
+	updated, _ := repo.Update(created.ID, created, nil)
+	updated.Title = "변경된 제목"
+
+	stored, _ := repo.GetByID(created.ID)
+	if stored.Title != "원래 제목" {
+		t.Errorf("수정 결과 변경이 저장소에 반영되면 안 됨. 실제 제목: %s", stored.Title)
+	}
+}
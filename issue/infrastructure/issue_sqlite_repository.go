@@ -0,0 +1,698 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"issue-service-aoroa/database"
+	issueModel "issue-service-aoroa/issue/model"
+	"issue-service-aoroa/issue/query"
+	"issue-service-aoroa/issue/search"
+	userModel "issue-service-aoroa/user/model"
+)
+
+const notDeleted = "i.deleted_at IS NULL"
+
+const selectIssues = `
+SELECT i.id, i.title, i.description, i.status, u.id, u.name, u.email, rp.id, rp.name, rp.email,
+	i.version, i.created_at, i.updated_at, i.deleted_at,
+	i.reopen_count, ro.id, ro.name, ro.email, i.reopen_reason, i.reopened_at
+FROM issues i
+LEFT JOIN users u ON u.id = i.user_id
+LEFT JOIN users rp ON rp.id = i.reporter_id
+LEFT JOIN users ro ON ro.id = i.reopened_by`
+
+type sqliteIssueRepository struct {
+	db *sql.DB
+}
+
+// 전문 검색 색인이 없는 이슈(색인 테이블이 생기기 전에 저장된 이슈)는 생성 시점에 색인한다
+func NewSQLiteIssueRepository(db *sql.DB) (IssueRepository, error) {
+	repo := &sqliteIssueRepository{db: db}
+	if err := repo.indexUnindexedIssues(); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (r *sqliteIssueRepository) Create(issue issueModel.Issue, history []issueModel.HistoryEntry) (issueModel.Issue, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return issueModel.Issue{}, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	result, err := tx.Exec(
+		`INSERT INTO issues (title, description, status, user_id, reporter_id, version, created_at, updated_at) VALUES (?, ?, ?, ?, ?, 1, ?, ?)`,
+		issue.Title, issue.Description, issue.Status, nullableUserID(issue.User), nullableUserID(issue.Reporter),
+		database.FormatTime(now), database.FormatTime(now),
+	)
+	if err != nil {
+		return issueModel.Issue{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return issueModel.Issue{}, err
+	}
+	if err := indexIssue(tx, uint(id), issue.Title, issue.Description); err != nil {
+		return issueModel.Issue{}, err
+	}
+	if err := insertHistory(tx, uint(id), history); err != nil {
+		return issueModel.Issue{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return issueModel.Issue{}, err
+	}
+
+	issue.ID = uint(id)
+	issue.Version = 1
+	issue.CreatedAt = now
+	issue.UpdatedAt = now
+	return issue, nil
+}
+
+func (r *sqliteIssueRepository) GetAll(includeDeleted bool) ([]issueModel.Issue, error) {
+	var conditions []string
+	if !includeDeleted {
+		conditions = append(conditions, notDeleted)
+	}
+	return r.queryIssues(selectIssues + whereClause(conditions) + ` ORDER BY i.id`)
+}
+
+func (r *sqliteIssueRepository) GetByID(id uint) (*issueModel.Issue, error) {
+	issue, err := scanIssue(r.db.QueryRow(selectIssues+` WHERE i.id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+func (r *sqliteIssueRepository) Update(id uint, updatedIssue issueModel.Issue, history []issueModel.HistoryEntry) (*issueModel.Issue, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	updatedIssue.ID = id
+	updated, err := updateIssue(tx, updatedIssue, history, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		tx.Rollback()
+		return nil, r.versionConflict(id, updatedIssue.Version)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(id)
+}
+
+func (r *sqliteIssueRepository) UpdateAll(updates []IssueUpdate) ([]issueModel.Issue, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	for _, update := range updates {
+		updated, err := updateIssue(tx, update.Issue, update.History, now)
+		if err != nil {
+			return nil, err
+		}
+		if !updated {
+			tx.Rollback()
+			if err := r.versionConflict(update.Issue.ID, update.Issue.Version); err != nil {
+				return nil, err
+			}
+			// 그사이 지워진 이슈
+			return nil, &issueModel.VersionConflictError{IssueID: update.Issue.ID, ExpectedVersion: update.Issue.Version}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	results := make([]issueModel.Issue, 0, len(updates))
+	for _, update := range updates {
+		issue, err := r.GetByID(update.Issue.ID)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *issue)
+	}
+	return results, nil
+}
+
+// 버전이 맞지 않거나 이슈가 없어 갱신된 행이 없으면 false를 반환한다
+func updateIssue(tx *sql.Tx, issue issueModel.Issue, history []issueModel.HistoryEntry, now time.Time) (bool, error) {
+	reopenedBy, reopenReason, reopenedAt := reopenColumns(issue.LastReopen)
+	result, err := tx.Exec(
+		`UPDATE issues SET title = ?, description = ?, status = ?, user_id = ?, version = version + 1, updated_at = ?, deleted_at = ?,
+			reopen_count = ?, reopened_by = ?, reopen_reason = ?, reopened_at = ?
+		WHERE id = ? AND version = ?`,
+		issue.Title, issue.Description, issue.Status, nullableUserID(issue.User),
+		database.FormatTime(now), nullableTime(issue.DeletedAt),
+		issue.ReopenCount, reopenedBy, reopenReason, reopenedAt,
+		issue.ID, issue.Version,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if err := indexIssue(tx, issue.ID, issue.Title, issue.Description); err != nil {
+		return false, err
+	}
+	return true, insertHistory(tx, issue.ID, history)
+}
+
+// 갱신된 행이 없을 때 이슈가 없는 것인지 버전이 어긋난 것인지 구분한다
+func (r *sqliteIssueRepository) versionConflict(id uint, expectedVersion uint) error {
+	var currentVersion uint
+	err := r.db.QueryRow(`SELECT version FROM issues WHERE id = ?`, id).Scan(&currentVersion)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return &issueModel.VersionConflictError{
+		IssueID:         id,
+		ExpectedVersion: expectedVersion,
+		CurrentVersion:  currentVersion,
+	}
+}
+
+func (r *sqliteIssueRepository) GetByStatus(status string, includeDeleted bool) ([]issueModel.Issue, error) {
+	conditions := []string{"i.status = ?"}
+	if !includeDeleted {
+		conditions = append(conditions, notDeleted)
+	}
+	return r.queryIssues(selectIssues+whereClause(conditions)+` ORDER BY i.id`, status)
+}
+
+var sortColumns = map[SortField]string{
+	SortByID:        "i.id",
+	SortByCreatedAt: "i.created_at",
+	SortByUpdatedAt: "i.updated_at",
+	SortByTitle:     "i.title",
+}
+
+func (r *sqliteIssueRepository) Find(filter IssueFilter, page PageRequest) (IssuePage, error) {
+	conditions, args := filterConditions(filter)
+	return r.findWhere(conditions, args, page)
+}
+
+func (r *sqliteIssueRepository) Search(q *query.Query, includeDeleted bool, page PageRequest) (IssuePage, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	if !includeDeleted {
+		conditions = append(conditions, notDeleted)
+	}
+	if q.Where != nil {
+		condition, queryArgs := queryCondition(q.Where)
+		conditions = append(conditions, condition)
+		args = append(args, queryArgs...)
+	}
+	return r.findWhere(conditions, args, page)
+}
+
+func (r *sqliteIssueRepository) findWhere(conditions []string, args []interface{}, page PageRequest) (IssuePage, error) {
+	var total int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM issues i`+whereClause(conditions), args...).Scan(&total); err != nil {
+		return IssuePage{}, err
+	}
+
+	column, ok := sortColumns[page.Sort.Field]
+	if !ok {
+		column = sortColumns[SortByID]
+	}
+	direction, comparison := "ASC", ">"
+	if page.Sort.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if page.After != nil {
+		if column == sortColumns[SortByID] {
+			conditions = append(conditions, "i.id "+comparison+" ?")
+			args = append(args, page.After.ID)
+		} else {
+			value, err := cursorColumnValue(page.After)
+			if err != nil {
+				return IssuePage{}, ErrInvalidCursor
+			}
+			conditions = append(conditions, fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND i.id %[2]s ?))", column, comparison))
+			args = append(args, value, value, page.After.ID)
+		}
+	}
+
+	// 다음 페이지가 있는지 알기 위해 한 건 더 조회한다
+	query := selectIssues + whereClause(conditions) +
+		fmt.Sprintf(" ORDER BY %s %s, i.id %s LIMIT ?", column, direction, direction)
+	issues, err := r.queryIssues(query, append(args, page.Limit+1)...)
+	if err != nil {
+		return IssuePage{}, err
+	}
+
+	result := IssuePage{Issues: issues, Total: total}
+	if len(issues) > page.Limit {
+		result.Issues = issues[:page.Limit]
+		if page.Limit > 0 {
+			result.NextCursor = cursorOf(result.Issues[page.Limit-1], page.Sort)
+		}
+	}
+	return result, nil
+}
+
+func filterConditions(filter IssueFilter) ([]string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, notDeleted)
+	}
+
+	if len(filter.Statuses) > 0 {
+		conditions = append(conditions, "i.status IN ("+placeholders(len(filter.Statuses))+")")
+		for _, status := range filter.Statuses {
+			args = append(args, status)
+		}
+	}
+
+	if filter.hasAssigneeCondition() {
+		var assignee []string
+		if len(filter.AssigneeIDs) > 0 {
+			assignee = append(assignee, "i.user_id IN ("+placeholders(len(filter.AssigneeIDs))+")")
+			for _, id := range filter.AssigneeIDs {
+				args = append(args, id)
+			}
+		}
+		if filter.Unassigned {
+			assignee = append(assignee, "i.user_id IS NULL")
+		}
+		conditions = append(conditions, "("+strings.Join(assignee, " OR ")+")")
+	}
+
+	if len(filter.ReporterIDs) > 0 {
+		conditions = append(conditions, "i.reporter_id IN ("+placeholders(len(filter.ReporterIDs))+")")
+		for _, id := range filter.ReporterIDs {
+			args = append(args, id)
+		}
+	}
+
+	for _, r := range []struct {
+		column   string
+		from, to *time.Time
+	}{
+		{"i.created_at", filter.CreatedFrom, filter.CreatedTo},
+		{"i.updated_at", filter.UpdatedFrom, filter.UpdatedTo},
+	} {
+		if r.from != nil {
+			conditions = append(conditions, r.column+" >= ?")
+			args = append(args, database.FormatTime(*r.from))
+		}
+		if r.to != nil {
+			conditions = append(conditions, r.column+" < ?")
+			args = append(args, database.FormatTime(*r.to))
+		}
+	}
+
+	// instr는 대소문자를 구분하므로 양쪽을 소문자로 맞춘다.
+	// 메모리 저장소와 같은 결과를 내도록 ASCII만 바꾸는 lower() 대신 unicode_lower()를 쓴다.
+	if filter.Keyword != "" {
+		conditions = append(conditions, "(instr(unicode_lower(i.title), unicode_lower(?)) > 0 OR instr(unicode_lower(i.description), unicode_lower(?)) > 0)")
+		args = append(args, filter.Keyword, filter.Keyword)
+	}
+
+	return conditions, args
+}
+
+func (r *sqliteIssueRepository) SearchText(text string, includeDeleted bool) ([]TextHit, error) {
+	terms := search.QueryTerms(text)
+	if len(terms) == 0 {
+		return []TextHit{}, nil
+	}
+
+	args := make([]interface{}, 0, len(terms))
+	for _, term := range terms {
+		args = append(args, term)
+	}
+	// 삭제된 이슈를 빼고 검색할 때는 문서 수와 문서 빈도도 삭제되지 않은 이슈만으로 센다
+	visible := ``
+	if !includeDeleted {
+		visible = ` AND t.issue_id IN (SELECT id FROM issues WHERE deleted_at IS NULL)`
+	}
+	rows, err := r.db.Query(
+		`SELECT t.term, t.issue_id, t.field, t.frequency FROM issue_terms t WHERE t.term IN (`+placeholders(len(terms))+`)`+visible, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	postings := map[string][]search.Posting{}
+	for rows.Next() {
+		var (
+			term    string
+			posting search.Posting
+		)
+		if err := rows.Scan(&term, &posting.DocumentID, &posting.Field, &posting.Frequency); err != nil {
+			return nil, err
+		}
+		postings[term] = append(postings[term], posting)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	countQuery := `SELECT COUNT(*) FROM issues`
+	if !includeDeleted {
+		countQuery += ` WHERE deleted_at IS NULL`
+	}
+	var totalDocuments int
+	if err := r.db.QueryRow(countQuery).Scan(&totalDocuments); err != nil {
+		return nil, err
+	}
+
+	hits := search.Rank(terms, postings, totalDocuments)
+	if len(hits) == 0 {
+		return []TextHit{}, nil
+	}
+
+	ids := make([]interface{}, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.DocumentID)
+	}
+	issues, err := r.queryIssues(selectIssues+` WHERE i.id IN (`+placeholders(len(ids))+`)`, ids...)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]issueModel.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+
+	result := make([]TextHit, 0, len(hits))
+	for _, hit := range hits {
+		result = append(result, TextHit{Issue: byID[hit.DocumentID], Score: hit.Score})
+	}
+	return result, nil
+}
+
+func (r *sqliteIssueRepository) Purge(id uint) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM issue_terms WHERE issue_id = ?`, id); err != nil {
+		return false, err
+	}
+	if _, err := tx.Exec(`DELETE FROM issue_history WHERE issue_id = ?`, id); err != nil {
+		return false, err
+	}
+	result, err := tx.Exec(`DELETE FROM issues WHERE id = ?`, id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, tx.Commit()
+}
+
+func (r *sqliteIssueRepository) History(issueID uint) ([]issueModel.HistoryEntry, error) {
+	rows, err := r.db.Query(`
+SELECT h.id, h.issue_id, h.action, h.field, h.old_value, h.new_value, a.id, a.name, a.email, h.request_id, h.comment, h.created_at
+FROM issue_history h
+LEFT JOIN users a ON a.id = h.actor_id
+WHERE h.issue_id = ?
+ORDER BY h.id`, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []issueModel.HistoryEntry{}
+	for rows.Next() {
+		var (
+			entry      issueModel.HistoryEntry
+			oldValue   sql.NullString
+			newValue   sql.NullString
+			actorID    sql.NullInt64
+			actorName  sql.NullString
+			actorEmail sql.NullString
+			createdAt  string
+		)
+		if err := rows.Scan(&entry.ID, &entry.IssueID, &entry.Action, &entry.Field, &oldValue, &newValue,
+			&actorID, &actorName, &actorEmail, &entry.RequestID, &entry.Comment, &createdAt); err != nil {
+			return nil, err
+		}
+
+		if oldValue.Valid {
+			entry.OldValue = &oldValue.String
+		}
+		if newValue.Valid {
+			entry.NewValue = &newValue.String
+		}
+		if actorID.Valid {
+			entry.Actor = &userModel.User{ID: uint(actorID.Int64), Name: actorName.String, Email: actorEmail.String}
+		}
+		if entry.At, err = database.ParseTime(createdAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// 변경 이력은 이슈를 저장하는 트랜잭션 안에서 함께 기록한다
+func insertHistory(tx *sql.Tx, issueID uint, history []issueModel.HistoryEntry) error {
+	for _, entry := range history {
+		if _, err := tx.Exec(
+			`INSERT INTO issue_history (issue_id, action, field, old_value, new_value, actor_id, request_id, comment, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			issueID, entry.Action, entry.Field, entry.OldValue, entry.NewValue, nullableUserID(entry.Actor),
+			entry.RequestID, entry.Comment, database.FormatTime(entry.At),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 이슈의 색인어를 모두 지우고 현재 제목과 설명으로 다시 색인한다
+func indexIssue(tx *sql.Tx, id uint, title, description string) error {
+	if _, err := tx.Exec(`DELETE FROM issue_terms WHERE issue_id = ?`, id); err != nil {
+		return err
+	}
+
+	for term, postings := range search.DocumentPostings(id, title, description) {
+		for _, posting := range postings {
+			if _, err := tx.Exec(
+				`INSERT INTO issue_terms (term, issue_id, field, frequency) VALUES (?, ?, ?, ?)`,
+				term, id, posting.Field, posting.Frequency,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *sqliteIssueRepository) indexUnindexedIssues() error {
+	rows, err := r.db.Query(
+		`SELECT id, title, description FROM issues WHERE id NOT IN (SELECT issue_id FROM issue_terms)`)
+	if err != nil {
+		return err
+	}
+
+	type document struct {
+		id                 uint
+		title, description string
+	}
+	var documents []document
+	for rows.Next() {
+		var doc document
+		if err := rows.Scan(&doc.id, &doc.title, &doc.description); err != nil {
+			rows.Close()
+			return err
+		}
+		documents = append(documents, doc)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, doc := range documents {
+		if err := indexIssue(tx, doc.id, doc.title, doc.description); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func cursorColumnValue(cursor *Cursor) (interface{}, error) {
+	switch cursor.Sort.Field {
+	case SortByCreatedAt, SortByUpdatedAt:
+		t, err := parseTimeSortValue(cursor.Value)
+		if err != nil {
+			return nil, err
+		}
+		return database.FormatTime(t), nil
+	default:
+		return cursor.Value, nil
+	}
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+func (r *sqliteIssueRepository) queryIssues(query string, args ...interface{}) ([]issueModel.Issue, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	issues := []issueModel.Issue{}
+	for rows.Next() {
+		issue, err := scanIssue(rows)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, *issue)
+	}
+	return issues, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanIssue(row rowScanner) (*issueModel.Issue, error) {
+	var (
+		issue     issueModel.Issue
+		userID    sql.NullInt64
+		userName  sql.NullString
+		userEmail sql.NullString
+		createdAt string
+
+		reporterID    sql.NullInt64
+		reporterName  sql.NullString
+		reporterEmail sql.NullString
+
+		updatedAt string
+		deletedAt sql.NullString
+
+		reopenedByID    sql.NullInt64
+		reopenedByName  sql.NullString
+		reopenedByEmail sql.NullString
+		reopenReason    sql.NullString
+		reopenedAt      sql.NullString
+	)
+
+	if err := row.Scan(&issue.ID, &issue.Title, &issue.Description, &issue.Status,
+		&userID, &userName, &userEmail, &reporterID, &reporterName, &reporterEmail,
+		&issue.Version, &createdAt, &updatedAt, &deletedAt,
+		&issue.ReopenCount, &reopenedByID, &reopenedByName, &reopenedByEmail, &reopenReason, &reopenedAt); err != nil {
+		return nil, err
+	}
+
+	if userID.Valid {
+		issue.User = &userModel.User{ID: uint(userID.Int64), Name: userName.String, Email: userEmail.String}
+	}
+	if reporterID.Valid {
+		issue.Reporter = &userModel.User{ID: uint(reporterID.Int64), Name: reporterName.String, Email: reporterEmail.String}
+	}
+
+	var err error
+	if issue.CreatedAt, err = database.ParseTime(createdAt); err != nil {
+		return nil, err
+	}
+	if issue.UpdatedAt, err = database.ParseTime(updatedAt); err != nil {
+		return nil, err
+	}
+	if deletedAt.Valid {
+		t, err := database.ParseTime(deletedAt.String)
+		if err != nil {
+			return nil, err
+		}
+		issue.DeletedAt = &t
+	}
+	if reopenedAt.Valid {
+		t, err := database.ParseTime(reopenedAt.String)
+		if err != nil {
+			return nil, err
+		}
+		issue.LastReopen = &issueModel.Reopening{
+			By:     userModel.User{ID: uint(reopenedByID.Int64), Name: reopenedByName.String, Email: reopenedByEmail.String},
+			Reason: reopenReason.String,
+			At:     t,
+		}
+	}
+	return &issue, nil
+}
+
+func nullableUserID(user *userModel.User) interface{} {
+	if user == nil {
+		return nil
+	}
+	return user.ID
+}
+
+func reopenColumns(reopen *issueModel.Reopening) (by, reason, at interface{}) {
+	if reopen == nil {
+		return nil, nil, nil
+	}
+	return reopen.By.ID, reopen.Reason, database.FormatTime(reopen.At)
+}
+
+func nullableTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return database.FormatTime(*t)
+}
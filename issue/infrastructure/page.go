@@ -0,0 +1,169 @@
+package infrastructure
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"issue-service-aoroa/internal/apperr"
+	issueModel "issue-service-aoroa/issue/model"
+)
+
+type SortField string
+
+const (
+	SortByID        SortField = "id"
+	SortByCreatedAt SortField = "createdAt"
+	SortByUpdatedAt SortField = "updatedAt"
+	SortByTitle     SortField = "title"
+)
+
+func IsValidSortField(field SortField) bool {
+	return field == SortByID || field == SortByCreatedAt || field == SortByUpdatedAt || field == SortByTitle
+}
+
+type SortOrder struct {
+	Field      SortField
+	Descending bool
+}
+
+// 페이지 경계를 가리키는 위치. 정렬 키 값과 ID를 함께 기록해
+// 정렬 키가 같은 이슈가 여럿이어도, 새 이슈가 추가되어도 다음 페이지가 어긋나지 않는다.
+type Cursor struct {
+	Sort  SortOrder
+	Value string
+	ID    uint
+}
+
+var ErrInvalidCursor = &apperr.ValidationError{
+	Field:   "cursor",
+	Reason:  "INVALID_CURSOR",
+	Message: "유효하지 않은 커서입니다",
+}
+
+type cursorPayload struct {
+	Field      SortField `json:"f"`
+	Descending bool      `json:"d,omitempty"`
+	Value      string    `json:"v,omitempty"`
+	ID         uint      `json:"i"`
+}
+
+// 커서는 클라이언트에게 불투명한 문자열로 전달된다
+func EncodeCursor(cursor *Cursor) string {
+	payload, _ := json.Marshal(cursorPayload{
+		Field:      cursor.Sort.Field,
+		Descending: cursor.Sort.Descending,
+		Value:      cursor.Value,
+		ID:         cursor.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func DecodeCursor(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil || !IsValidSortField(payload.Field) {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := &Cursor{
+		Sort:  SortOrder{Field: payload.Field, Descending: payload.Descending},
+		Value: payload.Value,
+		ID:    payload.ID,
+	}
+	if _, err := cursorIssue(cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+type PageRequest struct {
+	Limit int
+	Sort  SortOrder
+	After *Cursor
+}
+
+type IssuePage struct {
+	Issues     []issueModel.Issue
+	NextCursor *Cursor
+	Total      int
+}
+
+func cursorOf(issue issueModel.Issue, sort SortOrder) *Cursor {
+	return &Cursor{Sort: sort, Value: sortValue(issue, sort.Field), ID: issue.ID}
+}
+
+// 시간은 나노초 단위 정수로 기록해 저장소 구현과 무관하게 같은 순서를 갖게 한다
+func sortValue(issue issueModel.Issue, field SortField) string {
+	switch field {
+	case SortByCreatedAt:
+		return strconv.FormatInt(issue.CreatedAt.UnixNano(), 10)
+	case SortByUpdatedAt:
+		return strconv.FormatInt(issue.UpdatedAt.UnixNano(), 10)
+	case SortByTitle:
+		return issue.Title
+	default:
+		return ""
+	}
+}
+
+// 커서 위치를 정렬 비교에 쓸 수 있도록 정렬 키만 채운 이슈로 변환한다
+func cursorIssue(cursor *Cursor) (issueModel.Issue, error) {
+	issue := issueModel.Issue{ID: cursor.ID}
+
+	var err error
+	switch cursor.Sort.Field {
+	case SortByCreatedAt:
+		issue.CreatedAt, err = parseTimeSortValue(cursor.Value)
+	case SortByUpdatedAt:
+		issue.UpdatedAt, err = parseTimeSortValue(cursor.Value)
+	case SortByTitle:
+		issue.Title = cursor.Value
+	}
+	return issue, err
+}
+
+func parseTimeSortValue(value string) (time.Time, error) {
+	nanos, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, nanos), nil
+}
+
+// a가 b보다 정렬 순서상 앞이면 음수를 반환한다
+func compareIssues(a, b issueModel.Issue, sort SortOrder) int {
+	result := 0
+	switch sort.Field {
+	case SortByCreatedAt:
+		result = a.CreatedAt.Compare(b.CreatedAt)
+	case SortByUpdatedAt:
+		result = a.UpdatedAt.Compare(b.UpdatedAt)
+	case SortByTitle:
+		result = strings.Compare(a.Title, b.Title)
+	}
+	if result == 0 {
+		result = compareIDs(a.ID, b.ID)
+	}
+	if sort.Descending {
+		return -result
+	}
+	return result
+}
+
+func compareIDs(a, b uint) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,95 @@
+package infrastructure
+
+import (
+	"issue-service-aoroa/database"
+	"issue-service-aoroa/issue/query"
+)
+
+var queryColumns = map[query.Field]string{
+	query.FieldID:          "i.id",
+	query.FieldStatus:      "i.status",
+	query.FieldAssignee:    "i.user_id",
+	query.FieldTitle:       "i.title",
+	query.FieldDescription: "i.description",
+	query.FieldCreated:     "i.created_at",
+	query.FieldUpdated:     "i.updated_at",
+	query.FieldReopens:     "i.reopen_count",
+}
+
+// 검색 쿼리 식을 SQL 조건으로 바꾼다. 값은 모두 바인딩 인자로 전달한다.
+// 메모리 저장소의 평가 결과와 같도록 NULL이 될 수 있는 담당자 조건은 항상 참 또는 거짓이 되게 만든다.
+func queryCondition(expr query.Expr) (string, []interface{}) {
+	switch e := expr.(type) {
+	case *query.And:
+		return binaryCondition(e.Left, "AND", e.Right)
+	case *query.Or:
+		return binaryCondition(e.Left, "OR", e.Right)
+	case *query.Not:
+		condition, args := queryCondition(e.Expr)
+		return "NOT (" + condition + ")", args
+	case *query.Comparison:
+		return comparisonCondition(e)
+	default:
+		return "0", nil
+	}
+}
+
+func binaryCondition(left query.Expr, operator string, right query.Expr) (string, []interface{}) {
+	leftCondition, leftArgs := queryCondition(left)
+	rightCondition, rightArgs := queryCondition(right)
+	return "(" + leftCondition + " " + operator + " " + rightCondition + ")", append(leftArgs, rightArgs...)
+}
+
+func comparisonCondition(c *query.Comparison) (string, []interface{}) {
+	column := queryColumns[c.Field]
+	args := make([]interface{}, 0, len(c.Values))
+	for _, value := range c.Values {
+		args = append(args, queryArg(c.Field, value))
+	}
+
+	nullable := c.Field == query.FieldAssignee
+	switch c.Operator {
+	case query.OpIsEmpty, query.OpIsNotEmpty:
+		condition := column + " = ''"
+		if nullable {
+			condition = column + " IS NULL"
+		}
+		if c.Operator == query.OpIsNotEmpty {
+			condition = "NOT " + condition
+		}
+		return condition, nil
+	case query.OpEqual, query.OpNotEqual:
+		// IS와 IS NOT은 NULL끼리도 비교할 수 있는 SQLite의 동등 연산자이다
+		operator := map[query.Operator]string{query.OpEqual: "=", query.OpNotEqual: "!="}[c.Operator]
+		if nullable {
+			operator = map[query.Operator]string{query.OpEqual: "IS", query.OpNotEqual: "IS NOT"}[c.Operator]
+		}
+		return column + " " + operator + " ?", args
+	case query.OpIn, query.OpNotIn:
+		condition := column + " IN (" + placeholders(len(args)) + ")"
+		if nullable {
+			condition = "(" + column + " IS NOT NULL AND " + condition + ")"
+		}
+		if c.Operator == query.OpNotIn {
+			condition = "NOT " + condition
+		}
+		return condition, args
+	case query.OpContains:
+		return "instr(unicode_lower(" + column + "), unicode_lower(?)) > 0", args
+	case query.OpNotContains:
+		return "instr(unicode_lower(" + column + "), unicode_lower(?)) = 0", args
+	default:
+		return column + " " + string(c.Operator) + " ?", args
+	}
+}
+
+func queryArg(field query.Field, value query.Value) interface{} {
+	switch field {
+	case query.FieldID, query.FieldAssignee, query.FieldReopens:
+		return value.Number
+	case query.FieldCreated, query.FieldUpdated:
+		return database.FormatTime(value.Time)
+	default:
+		return value.Text
+	}
+}
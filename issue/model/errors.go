@@ -0,0 +1,58 @@
+package model
+
+import (
+	"fmt"
+
+	"issue-service-aoroa/internal/apperr"
+)
+
+var (
+	ErrIssueLocked = &apperr.DomainError{
+		Code:    "ISSUE_LOCKED",
+		Message: "완료되거나 취소된 이슈는 수정할 수 없습니다",
+	}
+	ErrInvalidStatus = &apperr.DomainError{
+		Code:    "INVALID_STATUS",
+		Message: "유효하지 않은 상태입니다",
+	}
+	ErrAssigneeRequired = &apperr.DomainError{
+		Code:    "ASSIGNEE_REQUIRED",
+		Message: "담당자 없이는 진행중 또는 완료 상태로 변경할 수 없습니다",
+	}
+	ErrTransitionNotAllowed = &apperr.DomainError{
+		Code:    "TRANSITION_NOT_ALLOWED",
+		Message: "현재 상태에서 요청한 상태로 변경할 수 없습니다",
+	}
+	ErrTransitionNotFound = &apperr.DomainError{
+		Code:    "TRANSITION_NOT_FOUND",
+		Message: "워크플로에 정의되지 않은 전이입니다",
+	}
+	ErrIssueNotClosed = &apperr.DomainError{
+		Code:    "ISSUE_NOT_CLOSED",
+		Message: "종료되지 않은 이슈는 다시 열 수 없습니다",
+	}
+	ErrIssueDeleted = &apperr.DomainError{
+		Code:    "ISSUE_DELETED",
+		Message: "삭제된 이슈입니다. 복원한 뒤 수정하세요",
+	}
+	ErrIssueNotDeleted = &apperr.DomainError{
+		Code:    "ISSUE_NOT_DELETED",
+		Message: "삭제되지 않은 이슈입니다",
+	}
+)
+
+const CodeVersionConflict = "VERSION_CONFLICT"
+
+type VersionConflictError struct {
+	IssueID         uint
+	ExpectedVersion uint
+	CurrentVersion  uint
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("이슈가 이미 다른 요청에 의해 수정되었습니다 (요청 버전: %d, 현재 버전: %d)", e.ExpectedVersion, e.CurrentVersion)
+}
+
+func (e *VersionConflictError) ErrorCode() string {
+	return CodeVersionConflict
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"issue-service-aoroa/internal/apperr"
+	userModel "issue-service-aoroa/user/model"
+)
+
+func TestChangeStatus_에러_타입_담당자_필요(t *testing.T) {
+	issue, _ := NewIssue("테스트", "설명", nil)
+
+	err := issue.ChangeStatus(StatusInProgress)
+
+	if !errors.Is(err, ErrAssigneeRequired) {
+		t.Errorf("ErrAssigneeRequired 에러여야 함. 실제: %v", err)
+	}
+}
+
+func TestAssignTo_에러_타입_수정_불가(t *testing.T) {
+	issue, _ := NewIssue("테스트", "설명", nil)
+	issue.Status = StatusCancelled
+
+	err := issue.AssignTo(&userModel.User{ID: 1, Name: "테스트"})
+
+	if !errors.Is(err, ErrIssueLocked) {
+		t.Errorf("ErrIssueLocked 에러여야 함. 실제: %v", err)
+	}
+}
+
+func TestNewIssue_에러_타입_검증_실패_필드명_포함(t *testing.T) {
+	_, err := NewIssue("", "설명", nil)
+
+	var validationErr *apperr.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("ValidationError여야 함. 실제: %v", err)
+	}
+	if validationErr.Field != "title" {
+		t.Errorf("검증 실패 필드는 title이어야 함. 실제: %s", validationErr.Field)
+	}
+	if validationErr.ErrorCode() != apperr.CodeValidationFailed {
+		t.Errorf("에러 코드는 %s여야 함. 실제: %s", apperr.CodeValidationFailed, validationErr.ErrorCode())
+	}
+}
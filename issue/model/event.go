@@ -0,0 +1,189 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	userModel "issue-service-aoroa/user/model"
+)
+
+// 이슈 상태를 바꾸는 메서드는 이벤트를 만들어 적용하고, 저장 전까지 Events()로 모아 둔다.
+// 이벤트에는 적용 결과(바뀐 상태 등)를 그대로 담아, 워크플로 설정이 바뀌어도 재생 결과가 달라지지 않게 한다
+type Event interface {
+	EventType() string
+	Apply(issue *Issue)
+}
+
+const (
+	EventIssueCreated    = "IssueCreated"
+	EventIssueAssigned   = "IssueAssigned"
+	EventIssueUnassigned = "IssueUnassigned"
+	EventStatusChanged   = "StatusChanged"
+	EventDetailsUpdated  = "DetailsUpdated"
+	EventIssueDeleted    = "IssueDeleted"
+	EventIssueRestored   = "IssueRestored"
+	EventIssueReopened   = "IssueReopened"
+	EventIssuePurged     = "IssuePurged"
+)
+
+type IssueCreated struct {
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Status      string          `json:"status"`
+	Assignee    *userModel.User `json:"assignee,omitempty"`
+	Reporter    *userModel.User `json:"reporter,omitempty"`
+}
+
+type IssueAssigned struct {
+	Assignee *userModel.User `json:"assignee"`
+	Status   string          `json:"status"`
+}
+
+type IssueUnassigned struct {
+	Status string `json:"status"`
+}
+
+type StatusChanged struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Transition string `json:"transition,omitempty"`
+}
+
+type DetailsUpdated struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type IssueDeleted struct {
+	At time.Time `json:"at"`
+}
+
+type IssueRestored struct{}
+
+type IssueReopened struct {
+	By     userModel.User `json:"by"`
+	Reason string         `json:"reason"`
+	At     time.Time      `json:"at"`
+	Status string         `json:"status"`
+}
+
+// 관리자가 이슈를 완전히 지운 기록. 이슈 메서드가 아니라 저장소가 남긴다
+type IssuePurged struct{}
+
+func (IssueCreated) EventType() string    { return EventIssueCreated }
+func (IssueAssigned) EventType() string   { return EventIssueAssigned }
+func (IssueUnassigned) EventType() string { return EventIssueUnassigned }
+func (StatusChanged) EventType() string   { return EventStatusChanged }
+func (DetailsUpdated) EventType() string  { return EventDetailsUpdated }
+func (IssueDeleted) EventType() string    { return EventIssueDeleted }
+func (IssueRestored) EventType() string   { return EventIssueRestored }
+func (IssueReopened) EventType() string   { return EventIssueReopened }
+func (IssuePurged) EventType() string     { return EventIssuePurged }
+
+func (e IssueCreated) Apply(issue *Issue) {
+	issue.Title = e.Title
+	issue.Description = e.Description
+	issue.Status = e.Status
+	issue.User = e.Assignee
+	issue.Reporter = e.Reporter
+}
+
+func (e IssueAssigned) Apply(issue *Issue) {
+	issue.User = e.Assignee
+	issue.Status = e.Status
+}
+
+func (e IssueUnassigned) Apply(issue *Issue) {
+	issue.User = nil
+	issue.Status = e.Status
+}
+
+func (e StatusChanged) Apply(issue *Issue) {
+	issue.Status = e.To
+}
+
+func (e DetailsUpdated) Apply(issue *Issue) {
+	issue.Title = e.Title
+	issue.Description = e.Description
+}
+
+func (e IssueDeleted) Apply(issue *Issue) {
+	at := e.At
+	issue.DeletedAt = &at
+}
+
+func (IssueRestored) Apply(issue *Issue) {
+	issue.DeletedAt = nil
+}
+
+func (e IssueReopened) Apply(issue *Issue) {
+	issue.Status = e.Status
+	issue.ReopenCount++
+	issue.LastReopen = &Reopening{By: e.By, Reason: e.Reason, At: e.At}
+}
+
+func (IssuePurged) Apply(*Issue) {}
+
+var eventFactories = map[string]func() Event{
+	EventIssueCreated:    func() Event { return &IssueCreated{} },
+	EventIssueAssigned:   func() Event { return &IssueAssigned{} },
+	EventIssueUnassigned: func() Event { return &IssueUnassigned{} },
+	EventStatusChanged:   func() Event { return &StatusChanged{} },
+	EventDetailsUpdated:  func() Event { return &DetailsUpdated{} },
+	EventIssueDeleted:    func() Event { return &IssueDeleted{} },
+	EventIssueRestored:   func() Event { return &IssueRestored{} },
+	EventIssueReopened:   func() Event { return &IssueReopened{} },
+	EventIssuePurged:     func() Event { return &IssuePurged{} },
+}
+
+// 이벤트 로그에서 읽은 데이터를 담을 빈 이벤트를 만든다. 반환값은 JSON 디코딩용 포인터다
+func NewEvent(eventType string) (Event, error) {
+	factory, ok := eventFactories[eventType]
+	if !ok {
+		return nil, fmt.Errorf("알 수 없는 이벤트 종류: %s", eventType)
+	}
+	return factory(), nil
+}
+
+// 이벤트를 적용하고 저장 대기 목록에 추가한다
+func (i *Issue) raise(event Event) {
+	event.Apply(i)
+	i.events = append(i.events, event)
+}
+
+// 마지막으로 불러온 뒤 발생한 이벤트. 이벤트 저장소가 아닌 저장소는 무시한다
+func (i *Issue) Events() []Event {
+	return i.events
+}
+
+func (i *Issue) ClearEvents() {
+	i.events = nil
+}
+
+// before를 after로 만드는 이벤트. 메서드를 거치지 않고 필드를 직접 바꾼 이슈를 저장할 때
+// 이벤트 저장소가 빠진 변경을 보충하는 데 쓴다. 다시 열기 기록은 비교하지 않는다
+func EventsBetween(before, after *Issue) []Event {
+	var events []Event
+
+	if before.Title != after.Title || before.Description != after.Description {
+		events = append(events, DetailsUpdated{Title: after.Title, Description: after.Description})
+	}
+
+	switch {
+	case after.User == nil && before.User != nil:
+		events = append(events, IssueUnassigned{Status: after.Status})
+	case after.User != nil && (before.User == nil || *before.User != *after.User):
+		events = append(events, IssueAssigned{Assignee: after.User, Status: after.Status})
+	case before.Status != after.Status:
+		events = append(events, StatusChanged{From: before.Status, To: after.Status})
+	}
+
+	switch {
+	case after.DeletedAt != nil && (before.DeletedAt == nil || !before.DeletedAt.Equal(*after.DeletedAt)):
+		events = append(events, IssueDeleted{At: *after.DeletedAt})
+	case after.DeletedAt == nil && before.DeletedAt != nil:
+		events = append(events, IssueRestored{})
+	}
+
+	return events
+}
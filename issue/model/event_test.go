@@ -0,0 +1,73 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	userModel "issue-service-aoroa/user/model"
+)
+
+func eventTypes(events []Event) string {
+	var types []string
+	for _, event := range events {
+		types = append(types, event.EventType())
+	}
+	return strings.Join(types, ",")
+}
+
+func TestIssue_이벤트를_재생하면_같은_상태(t *testing.T) {
+	user := &userModel.User{ID: 1, Name: "테스트 사용자"}
+	issue, _ := NewIssue("테스트", "설명", nil)
+	title := "새 제목"
+
+	issue.AssignTo(user)
+	issue.UpdateDetails(&title, nil)
+	issue.ChangeStatus(StatusCompleted)
+	issue.Reopen(*user, "재발", time.Now())
+	issue.Unassign()
+
+	expected := "IssueCreated,IssueAssigned,DetailsUpdated,StatusChanged,IssueReopened,IssueUnassigned"
+	if eventTypes(issue.Events()) != expected {
+		t.Errorf("예상 이벤트: %s, 실제: %s", expected, eventTypes(issue.Events()))
+	}
+
+	var replayed Issue
+	for _, event := range issue.Events() {
+		event.Apply(&replayed)
+	}
+	if replayed.Title != issue.Title || replayed.Status != issue.Status || replayed.User != nil || replayed.ReopenCount != 1 {
+		t.Errorf("재생한 상태가 다름. 예상: %+v, 실제: %+v", issue, replayed)
+	}
+}
+
+func TestIssue_변경이_없으면_이벤트도_없음(t *testing.T) {
+	issue, _ := NewIssue("테스트", "설명", nil)
+	issue.ClearEvents()
+	title := "테스트"
+
+	issue.UpdateDetails(&title, nil)
+	issue.ChangeStatus(StatusPending)
+
+	if len(issue.Events()) != 0 {
+		t.Errorf("이벤트가 없어야 함. 실제: %s", eventTypes(issue.Events()))
+	}
+}
+
+func TestEventsBetween_직접_바꾼_필드(t *testing.T) {
+	before, _ := NewIssue("테스트", "설명", nil)
+	after := *before
+	after.Status = StatusCompleted
+	after.User = &userModel.User{ID: 1, Name: "테스트 사용자"}
+
+	events := EventsBetween(before, &after)
+	if eventTypes(events) != EventIssueAssigned {
+		t.Fatalf("담당자와 상태는 할당 이벤트 하나로 기록되어야 함. 실제: %s", eventTypes(events))
+	}
+
+	replayed := *before
+	events[0].Apply(&replayed)
+	if replayed.Status != StatusCompleted || replayed.User.ID != 1 {
+		t.Errorf("보충한 이벤트로 같은 상태가 되어야 함: %+v", replayed)
+	}
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"strconv"
+	"time"
+
+	userModel "issue-service-aoroa/user/model"
+)
+
+// 이력 항목을 남긴 작업의 종류
+const (
+	HistoryCreated      = "CREATED"
+	HistoryUpdated      = "UPDATED"
+	HistoryTransitioned = "TRANSITIONED"
+	HistoryDeleted      = "DELETED"
+	HistoryRestored     = "RESTORED"
+	HistoryReopened     = "REOPENED"
+)
+
+// 이슈 필드 하나의 변경 기록. 값이 없던 필드(미할당 담당자 등)는 nil이다.
+// 한 번 기록된 항목은 수정하거나 지우지 않는다
+type HistoryEntry struct {
+	ID        uint            `json:"id"`
+	IssueID   uint            `json:"issueId"`
+	Action    string          `json:"action"`
+	Field     string          `json:"field"`
+	OldValue  *string         `json:"oldValue"`
+	NewValue  *string         `json:"newValue"`
+	Actor     *userModel.User `json:"actor"`
+	RequestID string          `json:"requestId,omitempty"`
+	Comment   string          `json:"comment,omitempty"`
+	At        time.Time       `json:"at"`
+}
+
+type FieldChange struct {
+	Field    string
+	OldValue *string
+	NewValue *string
+}
+
+// 이력에 남기는 필드. 담당자는 사용자 ID, 삭제 시각은 RFC 3339 문자열로 기록한다
+func historyValues(issue *Issue) map[string]*string {
+	values := map[string]*string{
+		"title":       &issue.Title,
+		"description": &issue.Description,
+		"status":      &issue.Status,
+		"assignee":    nil,
+		"deletedAt":   nil,
+	}
+	if issue.User != nil {
+		id := strconv.FormatUint(uint64(issue.User.ID), 10)
+		values["assignee"] = &id
+	}
+	if issue.DeletedAt != nil {
+		deletedAt := issue.DeletedAt.UTC().Format(time.RFC3339)
+		values["deletedAt"] = &deletedAt
+	}
+	return values
+}
+
+var historyFields = []string{"title", "description", "status", "assignee", "deletedAt"}
+
+// before가 nil이면 새로 만든 이슈로 보고, 값이 있는 필드를 모두 변경으로 반환한다
+func ChangesBetween(before, after *Issue) []FieldChange {
+	newValues := historyValues(after)
+	oldValues := map[string]*string{}
+	if before != nil {
+		oldValues = historyValues(before)
+	}
+
+	var changes []FieldChange
+	for _, field := range historyFields {
+		oldValue, newValue := oldValues[field], newValues[field]
+		if sameValue(oldValue, newValue) || (before == nil && isBlank(newValue)) {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: field, OldValue: copyValue(oldValue), NewValue: copyValue(newValue)})
+	}
+	return changes
+}
+
+func sameValue(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func isBlank(value *string) bool {
+	return value == nil || *value == ""
+}
+
+func copyValue(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	copied := *value
+	return &copied
+}
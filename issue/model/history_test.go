@@ -0,0 +1,67 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	userModel "issue-service-aoroa/user/model"
+)
+
+func changedFields(changes []FieldChange) map[string][2]string {
+	fields := map[string][2]string{}
+	for _, change := range changes {
+		var values [2]string
+		for i, value := range []*string{change.OldValue, change.NewValue} {
+			values[i] = "<nil>"
+			if value != nil {
+				values[i] = *value
+			}
+		}
+		fields[change.Field] = values
+	}
+	return fields
+}
+
+func TestChangesBetween_생성은_값이_있는_필드만(t *testing.T) {
+	issue, _ := NewIssue("테스트 이슈", "", &userModel.User{ID: 2, Name: "이디자인"})
+
+	fields := changedFields(ChangesBetween(nil, issue))
+
+	expected := map[string][2]string{
+		"title":    {"<nil>", "테스트 이슈"},
+		"status":   {"<nil>", StatusInProgress},
+		"assignee": {"<nil>", "2"},
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("예상 %v, 실제 %v", expected, fields)
+	}
+	for field, values := range expected {
+		if fields[field] != values {
+			t.Errorf("%s: 예상 %v, 실제 %v", field, values, fields[field])
+		}
+	}
+}
+
+func TestChangesBetween_바뀐_필드만(t *testing.T) {
+	issue, _ := NewIssue("테스트 이슈", "설명", &userModel.User{ID: 2, Name: "이디자인"})
+	before := *issue
+
+	issue.Unassign()
+	issue.Delete(time.Date(2025, 6, 12, 9, 0, 0, 0, time.UTC))
+
+	fields := changedFields(ChangesBetween(&before, issue))
+
+	expected := map[string][2]string{
+		"status":    {StatusInProgress, StatusPending},
+		"assignee":  {"2", "<nil>"},
+		"deletedAt": {"<nil>", "2025-06-12T09:00:00Z"},
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("예상 %v, 실제 %v", expected, fields)
+	}
+	for field, values := range expected {
+		if fields[field] != values {
+			t.Errorf("%s: 예상 %v, 실제 %v", field, values, fields[field])
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package model
 
 import (
-	"errors"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"issue-service-aoroa/internal/apperr"
 	userModel "issue-service-aoroa/user/model"
 )
 
@@ -12,122 +15,239 @@ type Issue struct {
 	Description string             `json:"description"`
 	Status      string             `json:"status"`
 	User        *userModel.User    `json:"user,omitempty"`
+	Reporter    *userModel.User    `json:"reporter,omitempty"`
+	Version     uint               `json:"version"`
 	CreatedAt   time.Time          `json:"createdAt"`
 	UpdatedAt   time.Time          `json:"updatedAt"`
+	DeletedAt   *time.Time         `json:"deletedAt,omitempty"`
+	ReopenCount uint               `json:"reopenCount"`
+	LastReopen  *Reopening         `json:"lastReopen,omitempty"`
+
+	events []Event
 }
 
-const (
-	StatusPending     = "PENDING"
-	StatusInProgress  = "IN_PROGRESS"
-	StatusCompleted   = "COMPLETED"
-	StatusCancelled   = "CANCELLED"
-)
+// 종료된 이슈를 다시 연 기록. 이슈에는 가장 최근 한 건만 남고, 횟수는 ReopenCount로 센다
+type Reopening struct {
+	By     userModel.User `json:"by"`
+	Reason string         `json:"reason"`
+	At     time.Time      `json:"at"`
+}
+
+const maxReopenReasonLength = 1000
 
 func NewIssue(title, description string, assignee *userModel.User) (*Issue, error) {
+	return NewReportedIssue(title, description, assignee, nil)
+}
+
+// reporter는 이슈를 등록한 사용자이며 생성 이벤트에만 담기므로 이후에는 바꿀 수 없다.
+// 인증 없이 등록한 이슈는 nil이다
+func NewReportedIssue(title, description string, assignee, reporter *userModel.User) (*Issue, error) {
 	if err := validateTitle(title); err != nil {
 		return nil, err
 	}
 
-	issue := &Issue{
+	issue := &Issue{}
+	issue.raise(IssueCreated{
 		Title:       title,
 		Description: description,
-		User:        assignee,
-	}
-
-	issue.setInitialStatus()
+		Status:      CurrentWorkflow().InitialStatus(assignee != nil),
+		Assignee:    assignee,
+		Reporter:    reporter,
+	})
 	return issue, nil
 }
 
+// 워크플로에서 종료 상태로 정의된 이슈는 수정할 수 없다
 func (i *Issue) IsUpdatable() bool {
-	return i.Status != StatusCompleted && i.Status != StatusCancelled
+	return !CurrentWorkflow().IsTerminal(i.Status)
+}
+
+func (i *Issue) IsDeleted() bool {
+	return i.DeletedAt != nil
+}
+
+// 삭제는 DeletedAt만 기록하며, 복원하면 삭제 전 상태 그대로 돌아온다
+func (i *Issue) Delete(now time.Time) error {
+	if i.IsDeleted() {
+		return ErrIssueDeleted
+	}
+
+	i.raise(IssueDeleted{At: now})
+	return nil
+}
+
+func (i *Issue) Restore() error {
+	if !i.IsDeleted() {
+		return ErrIssueNotDeleted
+	}
+
+	i.raise(IssueRestored{})
+	return nil
+}
+
+// 종료 상태의 이슈를 담당자 유무에 따라 워크플로의 초기 상태로 되돌린다.
+// 권한 확인은 호출하는 쪽에서 한다
+func (i *Issue) Reopen(by userModel.User, reason string, now time.Time) error {
+	if i.IsDeleted() {
+		return ErrIssueDeleted
+	}
+	if i.IsUpdatable() {
+		return ErrIssueNotClosed
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return &apperr.ValidationError{Field: "reason", Message: "다시 여는 사유는 필수입니다"}
+	}
+	if utf8.RuneCountInString(reason) > maxReopenReasonLength {
+		return &apperr.ValidationError{Field: "reason", Reason: "TOO_LONG", Message: "다시 여는 사유는 1000자 이하여야 합니다"}
+	}
+
+	i.raise(IssueReopened{
+		By:     by,
+		Reason: reason,
+		At:     now,
+		Status: CurrentWorkflow().InitialStatus(i.hasAssignee()),
+	})
+	return nil
 }
 
 func (i *Issue) AssignTo(user *userModel.User) error {
 	if !i.IsUpdatable() {
-		return errors.New("완료되거나 취소된 이슈는 수정할 수 없습니다")
+		return ErrIssueLocked
 	}
 
-	wasUnassigned := i.wasUnassigned()
-	i.User = user
-
-	if wasUnassigned && user != nil && i.isPending() {
-		i.Status = StatusInProgress
+	if user == nil {
+		i.raise(IssueUnassigned{Status: i.Status})
+		return nil
 	}
 
+	status := i.Status
+	if i.wasUnassigned() {
+		if next, ok := autoTransitionFrom(CurrentWorkflow().onAssign, i.Status); ok {
+			status = next
+		}
+	}
+	i.raise(IssueAssigned{Assignee: user, Status: status})
 	return nil
 }
 
 func (i *Issue) Unassign() error {
 	if !i.IsUpdatable() {
-		return errors.New("완료되거나 취소된 이슈는 수정할 수 없습니다")
+		return ErrIssueLocked
 	}
 
-	i.User = nil
-	i.Status = StatusPending
+	status := i.Status
+	if next, ok := autoTransitionFrom(CurrentWorkflow().onUnassign, i.Status); ok {
+		status = next
+	}
+	i.raise(IssueUnassigned{Status: status})
 	return nil
 }
 
 func (i *Issue) ChangeStatus(newStatus string) error {
 	if !i.IsUpdatable() {
-		return errors.New("완료되거나 취소된 이슈는 수정할 수 없습니다")
+		return ErrIssueLocked
 	}
 
 	if !IsValidStatus(newStatus) {
-		return errors.New("유효하지 않은 상태입니다")
+		return ErrInvalidStatus
 	}
 
 	if err := i.validateStatusTransition(newStatus); err != nil {
 		return err
 	}
 
-	i.Status = newStatus
+	if newStatus != i.Status {
+		i.raise(StatusChanged{From: i.Status, To: newStatus})
+	}
 	return nil
 }
 
+// 현재 상태에서 담당자 조건까지 만족해 바로 실행할 수 있는 전이를 워크플로에 정의된 순서대로 반환한다
+func (i *Issue) AvailableTransitions() []Transition {
+	if i.IsDeleted() || !i.IsUpdatable() {
+		return nil
+	}
+
+	var available []Transition
+	for _, transition := range CurrentWorkflow().TransitionsFrom(i.Status) {
+		if transition.Check(i) == nil {
+			available = append(available, transition)
+		}
+	}
+	return available
+}
+
+// 이름으로 지정한 워크플로 전이를 실행하고, 실행한 전이를 반환한다
+func (i *Issue) ApplyTransition(name string) (Transition, error) {
+	if i.IsDeleted() {
+		return Transition{}, ErrIssueDeleted
+	}
+	if !i.IsUpdatable() {
+		return Transition{}, ErrIssueLocked
+	}
+
+	transition, ok := CurrentWorkflow().TransitionByName(name)
+	if !ok {
+		return Transition{}, ErrTransitionNotFound
+	}
+	if !transition.StartsFrom(i.Status) {
+		return Transition{}, ErrTransitionNotAllowed
+	}
+	if err := transition.Check(i); err != nil {
+		return Transition{}, err
+	}
+
+	i.raise(StatusChanged{From: i.Status, To: transition.To, Transition: transition.Name})
+	return transition, nil
+}
+
 func (i *Issue) UpdateDetails(title, description *string) error {
 	if !i.IsUpdatable() {
-		return errors.New("완료되거나 취소된 이슈는 수정할 수 없습니다")
+		return ErrIssueLocked
 	}
 
+	updated := DetailsUpdated{Title: i.Title, Description: i.Description}
 	if title != nil {
 		if err := validateTitle(*title); err != nil {
 			return err
 		}
-		i.Title = *title
+		updated.Title = *title
 	}
 
 	if description != nil {
-		i.Description = *description
+		updated.Description = *description
 	}
 
+	if updated.Title != i.Title || updated.Description != i.Description {
+		i.raise(updated)
+	}
 	return nil
 }
 
-func (i *Issue) setInitialStatus() {
-	if i.hasAssignee() {
-		i.Status = StatusInProgress
-	} else {
-		i.Status = StatusPending
+// 같은 상태로의 변경은 아무 일도 하지 않고, 그 외에는 워크플로에 정의된 전이와 조건을 따른다
+func (i *Issue) validateStatusTransition(newStatus string) error {
+	if newStatus == i.Status {
+		return nil
 	}
-}
 
-func (i *Issue) validateStatusTransition(newStatus string) error {
-	if !i.hasAssignee() && i.requiresAssignee(newStatus) {
-		return errors.New("담당자 없이는 진행중 또는 완료 상태로 변경할 수 없습니다")
+	transition, ok := CurrentWorkflow().TransitionBetween(i.Status, newStatus)
+	if !ok {
+		return ErrTransitionNotAllowed
 	}
-	return nil
+	return transition.Check(i)
 }
 
 func validateTitle(title string) error {
 	if title == "" {
-		return errors.New("제목은 필수입니다")
+		return &apperr.ValidationError{Field: "title", Message: "제목은 필수입니다"}
 	}
 	return nil
 }
 
 func IsValidStatus(status string) bool {
-	return status == StatusPending || status == StatusInProgress || 
-		   status == StatusCompleted || status == StatusCancelled
+	return CurrentWorkflow().HasState(status)
 }
 
 func (i *Issue) wasUnassigned() bool {
@@ -137,11 +257,3 @@ func (i *Issue) wasUnassigned() bool {
 func (i *Issue) hasAssignee() bool {
 	return i.User != nil
 }
-
-func (i *Issue) isPending() bool {
-	return i.Status == StatusPending
-}
-
-func (i *Issue) requiresAssignee(status string) bool {
-	return status == StatusInProgress || status == StatusCompleted
-}
\ No newline at end of file
@@ -1,7 +1,11 @@
 package model
 
 import (
+	"errors"
 	"testing"
+	"time"
+
+	"issue-service-aoroa/internal/apperr"
 	userModel "issue-service-aoroa/user/model"
 )
 
@@ -182,4 +186,88 @@ func TestUpdateDetails_실패_빈_제목(t *testing.T) {
 	if err.Error() != expectedError {
 		t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
 	}
-}
\ No newline at end of file
+}
+func TestDelete_성공_삭제_후_복원(t *testing.T) {
+	issue, _ := NewIssue("테스트 이슈", "설명", nil)
+	now := time.Now()
+
+	if err := issue.Delete(now); err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if !issue.IsDeleted() || !issue.DeletedAt.Equal(now) {
+		t.Errorf("삭제 시각이 기록되어야 함. 실제: %v", issue.DeletedAt)
+	}
+	if err := issue.Delete(now); !errors.Is(err, ErrIssueDeleted) {
+		t.Errorf("이미 삭제된 이슈는 다시 삭제할 수 없어야 함. 실제: %v", err)
+	}
+
+	if err := issue.Restore(); err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if issue.IsDeleted() || issue.Status != StatusPending {
+		t.Errorf("삭제 전 상태로 복원되어야 함. 실제: %+v", issue)
+	}
+	if err := issue.Restore(); !errors.Is(err, ErrIssueNotDeleted) {
+		t.Errorf("삭제되지 않은 이슈는 복원할 수 없어야 함. 실제: %v", err)
+	}
+}
+
+func TestApplyTo_실패_삭제된_이슈(t *testing.T) {
+	issue, _ := NewIssue("테스트 이슈", "설명", nil)
+	issue.Delete(time.Now())
+
+	err := NewUpdateCommand().WithTitle("새 제목").ApplyTo(issue)
+
+	if !errors.Is(err, ErrIssueDeleted) {
+		t.Errorf("삭제된 이슈는 수정할 수 없어야 함. 실제: %v", err)
+	}
+}
+
+func TestReopen_성공_담당자_유무에_따라_상태_결정(t *testing.T) {
+	admin := userModel.User{ID: 3, Name: "박기획"}
+	now := time.Now()
+
+	assigned, _ := NewIssue("테스트 이슈", "설명", &userModel.User{ID: 1, Name: "김개발"})
+	assigned.ChangeStatus(StatusCompleted)
+	if err := assigned.Reopen(admin, "  같은 버그가 다시 발생  ", now); err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if assigned.Status != StatusInProgress || assigned.ReopenCount != 1 {
+		t.Errorf("담당자가 있으면 IN_PROGRESS로 다시 열려야 함. 실제: %+v", assigned)
+	}
+	if reopen := assigned.LastReopen; reopen == nil || reopen.By.ID != admin.ID || reopen.Reason != "같은 버그가 다시 발생" || !reopen.At.Equal(now) {
+		t.Errorf("다시 연 사용자, 사유, 시각이 기록되어야 함. 실제: %+v", reopen)
+	}
+
+	assigned.ChangeStatus(StatusCancelled)
+	assigned.Reopen(admin, "취소 철회", now)
+	if assigned.ReopenCount != 2 || assigned.LastReopen.Reason != "취소 철회" {
+		t.Errorf("다시 연 횟수가 누적되고 최근 기록만 남아야 함. 실제: %+v", assigned)
+	}
+
+	unassigned, _ := NewIssue("테스트 이슈", "설명", nil)
+	unassigned.ChangeStatus(StatusCancelled)
+	unassigned.Reopen(admin, "중복이 아니었음", now)
+	if unassigned.Status != StatusPending {
+		t.Errorf("담당자가 없으면 PENDING으로 다시 열려야 함. 실제: %s", unassigned.Status)
+	}
+}
+
+func TestReopen_실패(t *testing.T) {
+	admin := userModel.User{ID: 3, Name: "박기획"}
+
+	open, _ := NewIssue("테스트 이슈", "설명", nil)
+	if err := open.Reopen(admin, "사유", time.Now()); !errors.Is(err, ErrIssueNotClosed) {
+		t.Errorf("종료되지 않은 이슈는 다시 열 수 없어야 함. 실제: %v", err)
+	}
+
+	closed, _ := NewIssue("테스트 이슈", "설명", nil)
+	closed.ChangeStatus(StatusCancelled)
+	var validationErr *apperr.ValidationError
+	if err := closed.Reopen(admin, "  ", time.Now()); !errors.As(err, &validationErr) || validationErr.Field != "reason" {
+		t.Errorf("사유 검증 에러가 발생해야 함. 실제: %v", err)
+	}
+	if closed.Status != StatusCancelled || closed.ReopenCount != 0 {
+		t.Errorf("실패하면 이슈가 바뀌지 않아야 함. 실제: %+v", closed)
+	}
+}
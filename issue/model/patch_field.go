@@ -0,0 +1,29 @@
+package model
+
+// 부분 수정 요청의 필드 값. 요청에 없음(absent), null, 값 세 가지 상태를 구분한다.
+// 제로 값은 absent이다.
+type PatchField[T any] struct {
+	set   bool
+	null  bool
+	value T
+}
+
+func PatchValue[T any](value T) PatchField[T] {
+	return PatchField[T]{set: true, value: value}
+}
+
+func PatchNull[T any]() PatchField[T] {
+	return PatchField[T]{set: true, null: true}
+}
+
+func (f PatchField[T]) IsSet() bool {
+	return f.set
+}
+
+func (f PatchField[T]) IsNull() bool {
+	return f.set && f.null
+}
+
+func (f PatchField[T]) Value() (T, bool) {
+	return f.value, f.set && !f.null
+}
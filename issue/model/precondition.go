@@ -0,0 +1,40 @@
+package model
+
+import "issue-service-aoroa/internal/apperr"
+
+var ErrPreconditionFailed = &apperr.DomainError{
+	Code:    "PATCH_TEST_FAILED",
+	Message: "이슈의 현재 값이 패치의 test 조건과 일치하지 않습니다",
+}
+
+// 이슈에 변경을 적용하기 전에 현재 값이 기대와 같은지 확인하는 조건.
+// UserID가 null이면 담당자가 없어야 한다는 뜻이다.
+type Precondition struct {
+	Title       PatchField[string]
+	Description PatchField[string]
+	Status      PatchField[string]
+	UserID      PatchField[uint]
+	Version     PatchField[uint]
+}
+
+func (p *Precondition) Check(issue *Issue) error {
+	if !matches(p.Title, issue.Title) ||
+		!matches(p.Description, issue.Description) ||
+		!matches(p.Status, issue.Status) ||
+		!matches(p.Version, issue.Version) {
+		return ErrPreconditionFailed
+	}
+
+	if p.UserID.IsNull() && issue.hasAssignee() {
+		return ErrPreconditionFailed
+	}
+	if userID, ok := p.UserID.Value(); ok && (!issue.hasAssignee() || issue.User.ID != userID) {
+		return ErrPreconditionFailed
+	}
+	return nil
+}
+
+func matches[T comparable](expected PatchField[T], actual T) bool {
+	value, ok := expected.Value()
+	return !ok || value == actual
+}
@@ -1,29 +1,41 @@
 package model
 
 import (
-	"errors"
+	"issue-service-aoroa/internal/apperr"
 	userModel "issue-service-aoroa/user/model"
 )
 
 type UpdateCommand struct {
-	Title       *string
-	Description *string
-	Status      *string
-	UserID      *uint
+	Title       PatchField[string]
+	Description PatchField[string]
+	Status      PatchField[string]
+	UserID      PatchField[uint]
 	User        *userModel.User
 }
 
 func (cmd *UpdateCommand) ApplyTo(issue *Issue) error {
+	if issue.IsDeleted() {
+		return ErrIssueDeleted
+	}
+
 	if !issue.IsUpdatable() {
-		return errors.New("완료되거나 취소된 이슈는 수정할 수 없습니다")
+		return ErrIssueLocked
 	}
 
-	if err := issue.UpdateDetails(cmd.Title, cmd.Description); err != nil {
+	if cmd.Status.IsNull() {
+		return &apperr.ValidationError{Field: "status", Reason: "NULL_STATUS", Message: "상태는 null일 수 없습니다"}
+	}
+
+	title, description, err := cmd.details()
+	if err != nil {
+		return err
+	}
+	if err := issue.UpdateDetails(title, description); err != nil {
 		return err
 	}
 
-	if cmd.UserID != nil {
-		if *cmd.UserID == 0 || cmd.User == nil {
+	if cmd.UserID.IsSet() {
+		if _, ok := cmd.UserID.Value(); !ok || cmd.User == nil {
 			if err := issue.Unassign(); err != nil {
 				return err
 			}
@@ -34,8 +46,8 @@ func (cmd *UpdateCommand) ApplyTo(issue *Issue) error {
 		}
 	}
 
-	if cmd.Status != nil {
-		if err := issue.ChangeStatus(*cmd.Status); err != nil {
+	if status, ok := cmd.Status.Value(); ok {
+		if err := issue.ChangeStatus(status); err != nil {
 			return err
 		}
 	}
@@ -43,34 +55,61 @@ func (cmd *UpdateCommand) ApplyTo(issue *Issue) error {
 	return nil
 }
 
+// 제목은 null로 지울 수 없고, 설명을 null로 보내면 빈 문자열로 지운다
+func (cmd *UpdateCommand) details() (*string, *string, error) {
+	var title, description *string
+
+	if cmd.Title.IsNull() {
+		return nil, nil, &apperr.ValidationError{Field: "title", Message: "제목은 필수입니다"}
+	}
+	if value, ok := cmd.Title.Value(); ok {
+		title = &value
+	}
+
+	if cmd.Description.IsNull() {
+		empty := ""
+		description = &empty
+	}
+	if value, ok := cmd.Description.Value(); ok {
+		description = &value
+	}
+
+	return title, description, nil
+}
+
 func NewUpdateCommand() *UpdateCommand {
 	return &UpdateCommand{}
 }
 
 func (cmd *UpdateCommand) WithTitle(title string) *UpdateCommand {
-	cmd.Title = &title
+	cmd.Title = PatchValue(title)
 	return cmd
 }
 
 func (cmd *UpdateCommand) WithDescription(description string) *UpdateCommand {
-	cmd.Description = &description
+	cmd.Description = PatchValue(description)
 	return cmd
 }
 
 func (cmd *UpdateCommand) WithStatus(status string) *UpdateCommand {
-	cmd.Status = &status
+	cmd.Status = PatchValue(status)
 	return cmd
 }
 
 func (cmd *UpdateCommand) WithUser(userID uint, user *userModel.User) *UpdateCommand {
-	cmd.UserID = &userID
+	cmd.UserID = PatchValue(userID)
 	cmd.User = user
 	return cmd
 }
 
+func (cmd *UpdateCommand) WithUserID(userID uint) *UpdateCommand {
+	cmd.UserID = PatchValue(userID)
+	cmd.User = nil
+	return cmd
+}
+
 func (cmd *UpdateCommand) WithoutUser() *UpdateCommand {
-	userID := uint(0)
-	cmd.UserID = &userID
+	cmd.UserID = PatchNull[uint]()
 	cmd.User = nil
 	return cmd
-}
\ No newline at end of file
+}
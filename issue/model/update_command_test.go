@@ -1,7 +1,9 @@
 package model
 
 import (
+	"errors"
 	"testing"
+	"issue-service-aoroa/internal/apperr"
 	userModel "issue-service-aoroa/user/model"
 )
 
@@ -121,4 +123,42 @@ func TestUpdateCommand_ApplyTo_실패_빈_제목(t *testing.T) {
 	if err.Error() != expectedError {
 		t.Errorf("예상 에러: %s, 실제 에러: %s", expectedError, err.Error())
 	}
-}
\ No newline at end of file
+}
+func TestUpdateCommand_ApplyTo_실패_상태_null(t *testing.T) {
+	issue, _ := NewIssue("테스트", "설명", nil)
+
+	cmd := NewUpdateCommand()
+	cmd.Status = PatchNull[string]()
+
+	err := cmd.ApplyTo(issue)
+
+	var validationErr *apperr.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "status" || validationErr.Reason != "NULL_STATUS" {
+		t.Errorf("status 필드의 NULL_STATUS 검증 에러가 발생해야 함. 실제: %v", err)
+	}
+}
+
+func TestUpdateCommand_ApplyTo_성공_설명_null이면_비움(t *testing.T) {
+	issue, _ := NewIssue("테스트", "설명", nil)
+
+	cmd := NewUpdateCommand()
+	cmd.Description = PatchNull[string]()
+
+	if err := cmd.ApplyTo(issue); err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if issue.Description != "" {
+		t.Errorf("설명이 비워져야 함. 실제: %s", issue.Description)
+	}
+}
+
+func TestUpdateCommand_ApplyTo_성공_명시되지_않은_필드_유지(t *testing.T) {
+	issue, _ := NewIssue("테스트", "설명", nil)
+
+	if err := NewUpdateCommand().ApplyTo(issue); err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if issue.Title != "테스트" || issue.Description != "설명" || issue.Status != StatusPending {
+		t.Errorf("빈 명령은 이슈를 변경하지 않아야 함. 실제: %+v", issue)
+	}
+}
@@ -0,0 +1,317 @@
+package model
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"slices"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 기본 워크플로의 상태 이름. 다른 워크플로 파일을 쓰면 이 중 일부가 없을 수도 있다
+const (
+	StatusPending    = "PENDING"
+	StatusInProgress = "IN_PROGRESS"
+	StatusInReview   = "IN_REVIEW"
+	StatusBlocked    = "BLOCKED"
+	StatusCompleted  = "COMPLETED"
+	StatusCancelled  = "CANCELLED"
+)
+
+type Guard string
+
+// 담당자가 있어야 전이할 수 있다
+const GuardAssigneeRequired Guard = "assigneeRequired"
+
+var knownGuards = []Guard{GuardAssigneeRequired}
+
+// 전이를 실행할 수 있는 사용자. 관리자는 항상 실행할 수 있고,
+// 비어 있으면 이슈를 수정할 수 있는 사용자는 누구나 실행할 수 있다
+type Permission string
+
+const (
+	// 전이하기 전의 담당자만
+	PermissionAssignee Permission = "assignee"
+	// 관리자만
+	PermissionAdmin Permission = "admin"
+)
+
+var knownPermissions = []Permission{PermissionAssignee, PermissionAdmin}
+
+type State struct {
+	Name     string
+	Terminal bool
+}
+
+type Transition struct {
+	Name       string
+	From       []string
+	To         string
+	Guards     []Guard
+	Permission Permission
+}
+
+func (t Transition) StartsFrom(status string) bool {
+	return slices.Contains(t.From, status)
+}
+
+// 이슈가 전이 조건을 만족하지 않으면 해당 에러를 반환한다
+func (t Transition) Check(issue *Issue) error {
+	for _, guard := range t.Guards {
+		switch guard {
+		case GuardAssigneeRequired:
+			if !issue.hasAssignee() {
+				return ErrAssigneeRequired
+			}
+		}
+	}
+	return nil
+}
+
+// 담당자 지정/해제 시 From 상태에 있으면 To 상태로 자동 전이한다
+type AutoTransition struct {
+	From []string
+	To   string
+}
+
+// 이슈 상태, 허용되는 전이와 자동 전이를 정의한다.
+// 생성 후에는 바뀌지 않으므로 여러 고루틴에서 함께 읽어도 안전하다
+type Workflow struct {
+	states            []State
+	transitions       []Transition
+	initialUnassigned string
+	initialAssigned   string
+	onAssign          []AutoTransition
+	onUnassign        []AutoTransition
+}
+
+//go:embed default_workflow.yaml
+var defaultWorkflowYAML []byte
+
+var activeWorkflow atomic.Pointer[Workflow]
+
+func init() {
+	workflow, err := ParseWorkflow(defaultWorkflowYAML)
+	if err != nil {
+		panic(fmt.Sprintf("기본 워크플로를 읽을 수 없습니다: %v", err))
+	}
+	activeWorkflow.Store(workflow)
+}
+
+// 이슈 도메인 규칙이 참고하는 워크플로를 반환한다
+func CurrentWorkflow() *Workflow {
+	return activeWorkflow.Load()
+}
+
+// 애플리케이션 시작 시 설정 파일에서 읽은 워크플로로 교체한다
+func SetWorkflow(workflow *Workflow) {
+	activeWorkflow.Store(workflow)
+}
+
+func DefaultWorkflow() *Workflow {
+	workflow, _ := ParseWorkflow(defaultWorkflowYAML)
+	return workflow
+}
+
+type workflowFile struct {
+	Initial struct {
+		Unassigned string `yaml:"unassigned"`
+		Assigned   string `yaml:"assigned"`
+	} `yaml:"initial"`
+	States []struct {
+		Name     string `yaml:"name"`
+		Terminal bool   `yaml:"terminal"`
+	} `yaml:"states"`
+	Transitions []struct {
+		Name       string     `yaml:"name"`
+		From       []string   `yaml:"from"`
+		To         string     `yaml:"to"`
+		Guards     []Guard    `yaml:"guards"`
+		Permission Permission `yaml:"permission"`
+	} `yaml:"transitions"`
+	AutoTransitions struct {
+		OnAssign   []autoTransitionFile `yaml:"onAssign"`
+		OnUnassign []autoTransitionFile `yaml:"onUnassign"`
+	} `yaml:"autoTransitions"`
+}
+
+type autoTransitionFile struct {
+	From []string `yaml:"from"`
+	To   string   `yaml:"to"`
+}
+
+// YAML 워크플로 정의를 읽고, 존재하지 않는 상태나 중복된 이름처럼 잘못된 정의는 에러로 반환한다
+func ParseWorkflow(data []byte) (*Workflow, error) {
+	var file workflowFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("워크플로 정의를 해석할 수 없습니다: %w", err)
+	}
+
+	workflow := &Workflow{
+		initialUnassigned: file.Initial.Unassigned,
+		initialAssigned:   file.Initial.Assigned,
+	}
+
+	if len(file.States) == 0 {
+		return nil, fmt.Errorf("워크플로에 상태가 하나도 없습니다")
+	}
+	for _, state := range file.States {
+		if state.Name == "" {
+			return nil, fmt.Errorf("상태 이름이 비어 있습니다")
+		}
+		if workflow.HasState(state.Name) {
+			return nil, fmt.Errorf("상태 %s가 중복 정의되었습니다", state.Name)
+		}
+		workflow.states = append(workflow.states, State{Name: state.Name, Terminal: state.Terminal})
+	}
+
+	for _, initial := range []string{workflow.initialUnassigned, workflow.initialAssigned} {
+		if err := workflow.checkState(initial, "초기 상태"); err != nil {
+			return nil, err
+		}
+		if workflow.IsTerminal(initial) {
+			return nil, fmt.Errorf("초기 상태 %s는 종료 상태일 수 없습니다", initial)
+		}
+	}
+
+	for _, transition := range file.Transitions {
+		if transition.Name == "" {
+			return nil, fmt.Errorf("전이 이름이 비어 있습니다")
+		}
+		if _, exists := workflow.TransitionByName(transition.Name); exists {
+			return nil, fmt.Errorf("전이 %s가 중복 정의되었습니다", transition.Name)
+		}
+		if len(transition.From) == 0 {
+			return nil, fmt.Errorf("전이 %s에 from 상태가 없습니다", transition.Name)
+		}
+		for _, from := range transition.From {
+			if err := workflow.checkState(from, "전이 "+transition.Name+"의 from"); err != nil {
+				return nil, err
+			}
+			if workflow.IsTerminal(from) {
+				return nil, fmt.Errorf("전이 %s: 종료 상태 %s에서는 전이할 수 없습니다", transition.Name, from)
+			}
+		}
+		if err := workflow.checkState(transition.To, "전이 "+transition.Name+"의 to"); err != nil {
+			return nil, err
+		}
+		for _, guard := range transition.Guards {
+			if !slices.Contains(knownGuards, guard) {
+				return nil, fmt.Errorf("전이 %s: 알 수 없는 조건 %s", transition.Name, guard)
+			}
+		}
+		if transition.Permission != "" && !slices.Contains(knownPermissions, transition.Permission) {
+			return nil, fmt.Errorf("전이 %s: 알 수 없는 권한 %s", transition.Name, transition.Permission)
+		}
+		workflow.transitions = append(workflow.transitions, Transition{
+			Name:       transition.Name,
+			From:       transition.From,
+			To:         transition.To,
+			Guards:     transition.Guards,
+			Permission: transition.Permission,
+		})
+	}
+
+	var err error
+	if workflow.onAssign, err = workflow.autoTransitions(file.AutoTransitions.OnAssign, "onAssign"); err != nil {
+		return nil, err
+	}
+	if workflow.onUnassign, err = workflow.autoTransitions(file.AutoTransitions.OnUnassign, "onUnassign"); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+func (w *Workflow) autoTransitions(files []autoTransitionFile, kind string) ([]AutoTransition, error) {
+	autos := make([]AutoTransition, 0, len(files))
+	for _, file := range files {
+		for _, from := range append(slices.Clone(file.From), file.To) {
+			if err := w.checkState(from, "자동 전이 "+kind); err != nil {
+				return nil, err
+			}
+		}
+		autos = append(autos, AutoTransition{From: file.From, To: file.To})
+	}
+	return autos, nil
+}
+
+func (w *Workflow) checkState(name, where string) error {
+	if !w.HasState(name) {
+		return fmt.Errorf("%s: 정의되지 않은 상태 %q", where, name)
+	}
+	return nil
+}
+
+func (w *Workflow) States() []State {
+	return slices.Clone(w.states)
+}
+
+func (w *Workflow) HasState(name string) bool {
+	_, ok := w.state(name)
+	return ok
+}
+
+func (w *Workflow) IsTerminal(name string) bool {
+	state, ok := w.state(name)
+	return ok && state.Terminal
+}
+
+func (w *Workflow) state(name string) (State, bool) {
+	for _, state := range w.states {
+		if state.Name == name {
+			return state, true
+		}
+	}
+	return State{}, false
+}
+
+func (w *Workflow) InitialStatus(assigned bool) string {
+	if assigned {
+		return w.initialAssigned
+	}
+	return w.initialUnassigned
+}
+
+// from 상태에서 시작할 수 있는 전이를 정의된 순서대로 반환한다
+func (w *Workflow) TransitionsFrom(from string) []Transition {
+	var transitions []Transition
+	for _, transition := range w.transitions {
+		if transition.StartsFrom(from) {
+			transitions = append(transitions, transition)
+		}
+	}
+	return transitions
+}
+
+func (w *Workflow) TransitionByName(name string) (Transition, bool) {
+	for _, transition := range w.transitions {
+		if transition.Name == name {
+			return transition, true
+		}
+	}
+	return Transition{}, false
+}
+
+// from에서 to로 가는 전이가 여럿이면 먼저 정의된 것을 반환한다
+func (w *Workflow) TransitionBetween(from, to string) (Transition, bool) {
+	for _, transition := range w.TransitionsFrom(from) {
+		if transition.To == to {
+			return transition, true
+		}
+	}
+	return Transition{}, false
+}
+
+func autoTransitionFrom(autos []AutoTransition, from string) (string, bool) {
+	for _, auto := range autos {
+		if slices.Contains(auto.From, from) {
+			return auto.To, true
+		}
+	}
+	return "", false
+}
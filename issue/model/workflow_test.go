@@ -0,0 +1,182 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	userModel "issue-service-aoroa/user/model"
+)
+
+// QA 팀 워크플로 예시: 검토 단계가 있고, 담당자를 지정해도 자동으로 시작하지 않는다
+const qaWorkflowYAML = `
+initial:
+  unassigned: OPEN
+  assigned: OPEN
+states:
+  - name: OPEN
+  - name: IN_REVIEW
+  - name: DONE
+    terminal: true
+transitions:
+  - name: request_review
+    from: [OPEN]
+    to: IN_REVIEW
+    guards: [assigneeRequired]
+  - name: approve
+    from: [IN_REVIEW]
+    to: DONE
+autoTransitions:
+  onUnassign:
+    - from: [IN_REVIEW]
+      to: OPEN
+`
+
+func useWorkflow(t *testing.T, definition string) {
+	t.Helper()
+	workflow, err := ParseWorkflow([]byte(definition))
+	if err != nil {
+		t.Fatalf("워크플로 정의 오류: %v", err)
+	}
+	SetWorkflow(workflow)
+	t.Cleanup(func() { SetWorkflow(DefaultWorkflow()) })
+}
+
+func TestDefaultWorkflow_상태와_전이(t *testing.T) {
+	workflow := DefaultWorkflow()
+
+	var names []string
+	for _, state := range workflow.States() {
+		names = append(names, state.Name)
+	}
+	expected := "PENDING,IN_PROGRESS,IN_REVIEW,BLOCKED,COMPLETED,CANCELLED"
+	if strings.Join(names, ",") != expected {
+		t.Errorf("예상 상태: %s, 실제: %v", expected, names)
+	}
+	if !workflow.IsTerminal(StatusCompleted) || !workflow.IsTerminal(StatusCancelled) || workflow.IsTerminal(StatusBlocked) {
+		t.Error("COMPLETED와 CANCELLED만 종료 상태여야 함")
+	}
+
+	var transitions []string
+	for _, transition := range workflow.TransitionsFrom(StatusBlocked) {
+		transitions = append(transitions, transition.Name)
+	}
+	if strings.Join(transitions, ",") != "start,stop,cancel" {
+		t.Errorf("BLOCKED에서 가능한 전이가 다름. 실제: %v", transitions)
+	}
+}
+
+func TestParseWorkflow_실패_잘못된_정의(t *testing.T) {
+	tests := map[string]string{
+		"정의되지 않은 상태": `
+initial: {unassigned: OPEN, assigned: OPEN}
+states: [{name: OPEN}]
+transitions:
+  - {name: close, from: [OPEN], to: CLOSED}
+`,
+		"중복된 전이 이름": `
+initial: {unassigned: OPEN, assigned: OPEN}
+states: [{name: OPEN}, {name: CLOSED}]
+transitions:
+  - {name: close, from: [OPEN], to: CLOSED}
+  - {name: close, from: [OPEN], to: OPEN}
+`,
+		"알 수 없는 조건": `
+initial: {unassigned: OPEN, assigned: OPEN}
+states: [{name: OPEN}, {name: CLOSED}]
+transitions:
+  - {name: close, from: [OPEN], to: CLOSED, guards: [approved]}
+`,
+		"알 수 없는 권한": `
+initial: {unassigned: OPEN, assigned: OPEN}
+states: [{name: OPEN}, {name: CLOSED}]
+transitions:
+  - {name: close, from: [OPEN], to: CLOSED, permission: owner}
+`,
+		"종료 상태에서 시작하는 전이": `
+initial: {unassigned: OPEN, assigned: OPEN}
+states: [{name: OPEN}, {name: CLOSED, terminal: true}]
+transitions:
+  - {name: reopen, from: [CLOSED], to: OPEN}
+`,
+		"종료 상태인 초기 상태": `
+initial: {unassigned: OPEN, assigned: CLOSED}
+states: [{name: OPEN}, {name: CLOSED, terminal: true}]
+`,
+		"알 수 없는 키": `
+initial: {unassigned: OPEN, assigned: OPEN}
+states: [{name: OPEN, final: true}]
+`,
+		"상태 없음": `
+initial: {unassigned: OPEN, assigned: OPEN}
+`,
+	}
+
+	for name, definition := range tests {
+		if _, err := ParseWorkflow([]byte(definition)); err == nil {
+			t.Errorf("%s: 에러가 발생해야 함", name)
+		}
+	}
+}
+
+func TestChangeStatus_실패_정의되지_않은_전이(t *testing.T) {
+	user := &userModel.User{ID: 1, Name: "테스트 사용자"}
+	issue, _ := NewIssue("테스트", "설명", user)
+	issue.ChangeStatus(StatusBlocked)
+
+	if err := issue.ChangeStatus(StatusCompleted); !errors.Is(err, ErrTransitionNotAllowed) {
+		t.Errorf("BLOCKED에서 COMPLETED로는 바로 갈 수 없어야 함. 실제: %v", err)
+	}
+	if err := issue.ChangeStatus(StatusInProgress); err != nil || issue.Status != StatusInProgress {
+		t.Errorf("BLOCKED에서 다시 시작할 수 있어야 함. 실제: %s, %v", issue.Status, err)
+	}
+}
+
+func TestUnassign_자동_전이가_없는_상태는_유지(t *testing.T) {
+	user := &userModel.User{ID: 1, Name: "테스트 사용자"}
+	issue, _ := NewIssue("테스트", "설명", user)
+	issue.ChangeStatus(StatusBlocked)
+
+	issue.Unassign()
+
+	if issue.Status != StatusBlocked {
+		t.Errorf("BLOCKED 이슈는 담당자를 해제해도 BLOCKED여야 함. 실제: %s", issue.Status)
+	}
+}
+
+func TestIssue_설정한_워크플로를_따름(t *testing.T) {
+	useWorkflow(t, qaWorkflowYAML)
+	user := &userModel.User{ID: 1, Name: "테스트 사용자"}
+
+	issue, _ := NewIssue("테스트", "설명", nil)
+	if issue.Status != "OPEN" {
+		t.Fatalf("초기 상태는 OPEN이어야 함. 실제: %s", issue.Status)
+	}
+	if IsValidStatus(StatusPending) {
+		t.Error("워크플로에 없는 상태는 유효하지 않아야 함")
+	}
+
+	if err := issue.ChangeStatus("IN_REVIEW"); !errors.Is(err, ErrAssigneeRequired) {
+		t.Errorf("담당자 없이 검토 요청할 수 없어야 함. 실제: %v", err)
+	}
+
+	issue.AssignTo(user)
+	if issue.Status != "OPEN" {
+		t.Errorf("onAssign 자동 전이가 없으면 상태가 유지되어야 함. 실제: %s", issue.Status)
+	}
+
+	issue.ChangeStatus("IN_REVIEW")
+	issue.Unassign()
+	if issue.Status != "OPEN" {
+		t.Errorf("검토 중 담당자를 해제하면 OPEN으로 돌아가야 함. 실제: %s", issue.Status)
+	}
+
+	issue.AssignTo(user)
+	issue.ChangeStatus("IN_REVIEW")
+	if err := issue.ChangeStatus("DONE"); err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if err := issue.ChangeStatus("OPEN"); !errors.Is(err, ErrIssueLocked) {
+		t.Errorf("종료 상태의 이슈는 수정할 수 없어야 함. 실제: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package presentation
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/issue/application"
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+	mentionApp "issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	userApp "issue-service-aoroa/user/application"
+	userInfra "issue-service-aoroa/user/infrastructure"
+
+	"github.com/gin-gonic/gin"
+)
+
+const testJWTSecret = "test-jwt-secret"
+
+// 인증 미들웨어를 거치는 서버. 1번 사용자는 API 키, 나머지는 JWT로 인증한다
+func setupAuthTestServer() (*gin.Engine, userInfra.UserRepository) {
+	gin.SetMode(gin.TestMode)
+
+	userRepo := userInfra.NewUserRepository()
+	issueRepo := infrastructure.NewIssueRepository()
+	mentions := mentionApp.NewMentionService(mentionInfra.NewMentionRepository(), issueRepo, userRepo)
+	controller := NewIssueController(application.NewIssueService(issueRepo, userRepo, commentInfra.NewCommentRepository(), mentions))
+	authenticator := userApp.NewAuthenticator(userRepo, userApp.AuthConfig{
+		JWTSecret: []byte(testJWTSecret),
+		APIKeys:   map[string]uint{"dev-key": 1},
+	})
+
+	router := gin.New()
+	router.Use(httpx.RequestID())
+	router.Use(httpx.ErrorHandler(ErrorMapping))
+	router.Use(httpx.Authenticate(authenticator))
+	router.POST("/issue", controller.CreateIssue)
+	router.GET("/issues", controller.GetIssues)
+	router.DELETE("/issue/:id", controller.DeleteIssue)
+	router.GET("/issue/:id/transitions", controller.GetIssueTransitions)
+	router.GET("/issue/:id/history", controller.GetIssueHistory)
+	router.POST("/issue/:id/transitions/:name", controller.TransitionIssue)
+	router.DELETE("/admin/issue/:id", controller.PurgeIssue)
+	router.POST("/admin/issue/:id/reopen", controller.ReopenIssue)
+	return router, userRepo
+}
+
+func createIssueAs(t *testing.T, router *gin.Engine, headers map[string]string) model.Issue {
+	recorder := authRequest(router, http.MethodPost, "/issue", `{"title": "로그인 오류"}`, headers)
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("이슈 생성 실패: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var issue model.Issue
+	json.Unmarshal(recorder.Body.Bytes(), &issue)
+	return issue
+}
+
+func bearerToken(userID uint, expiresAt time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"%d","exp":%d}`, userID, expiresAt.Unix())))
+	mac := hmac.New(sha256.New, []byte(testJWTSecret))
+	mac.Write([]byte(header + "." + payload))
+	return "Bearer " + header + "." + payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func authRequest(router *gin.Engine, method, path, body string, headers map[string]string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestAuthenticate_인증된_사용자가_변경_이력에_남음(t *testing.T) {
+	router, _ := setupAuthTestServer()
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		actorID uint
+	}{
+		{"JWT", map[string]string{"Authorization": bearerToken(2, time.Now().Add(time.Hour))}, 2},
+		{"API 키", map[string]string{httpx.APIKeyHeader: "dev-key"}, 1},
+	}
+
+	for _, tt := range tests {
+		recorder := authRequest(router, http.MethodPost, "/issue", `{"title": "로그인 오류"}`, tt.headers)
+		if recorder.Code != http.StatusCreated {
+			t.Fatalf("%s: 201이어야 함. 실제: %d, %s", tt.name, recorder.Code, recorder.Body.String())
+		}
+		var issue model.Issue
+		json.Unmarshal(recorder.Body.Bytes(), &issue)
+
+		recorder = authRequest(router, http.MethodGet, fmt.Sprintf("/issue/%d/history", issue.ID), "", tt.headers)
+		var response struct {
+			History []model.HistoryEntry `json:"history"`
+		}
+		json.Unmarshal(recorder.Body.Bytes(), &response)
+		if len(response.History) == 0 || response.History[0].Actor == nil || response.History[0].Actor.ID != tt.actorID {
+			t.Errorf("%s: 생성 이력에 인증된 사용자가 남아야 함: %s", tt.name, recorder.Body.String())
+		}
+	}
+}
+
+func TestAuthenticate_실패(t *testing.T) {
+	router, userRepo := setupAuthTestServer()
+	inactive, _ := userRepo.GetByID(3)
+	inactive.Active = false
+	userRepo.Update(*inactive)
+
+	tests := []struct {
+		name      string
+		headers   map[string]string
+		status    int
+		errorCode string
+	}{
+		{"인증 정보 없음", nil, http.StatusUnauthorized, "AUTHENTICATION_REQUIRED"},
+		{"Bearer가 아닌 방식", map[string]string{"Authorization": "Basic ZGV2OmtleQ=="}, http.StatusUnauthorized, "INVALID_CREDENTIALS"},
+		{"잘못된 API 키", map[string]string{httpx.APIKeyHeader: "wrong-key"}, http.StatusUnauthorized, "INVALID_CREDENTIALS"},
+		{"만료된 토큰", map[string]string{"Authorization": bearerToken(2, time.Now().Add(-time.Minute))}, http.StatusUnauthorized, "TOKEN_EXPIRED"},
+		{"비활성화된 사용자", map[string]string{"Authorization": bearerToken(3, time.Now().Add(time.Hour))}, http.StatusForbidden, "USER_INACTIVE"},
+	}
+
+	for _, tt := range tests {
+		recorder := authRequest(router, http.MethodPost, "/issue", `{"title": "로그인 오류"}`, tt.headers)
+		if recorder.Code != tt.status || !strings.Contains(recorder.Body.String(), tt.errorCode) {
+			t.Errorf("%s: %d %s이어야 함. 실제: %d, %s", tt.name, tt.status, tt.errorCode, recorder.Code, recorder.Body.String())
+		}
+		if challenge := recorder.Header().Get("WWW-Authenticate"); (tt.status == http.StatusUnauthorized) != (challenge == "Bearer") {
+			t.Errorf("%s: 401일 때만 WWW-Authenticate 헤더가 있어야 함. 실제: %q", tt.name, challenge)
+		}
+	}
+}
+
+func TestAuthorize_역할에_따라_403과_사유(t *testing.T) {
+	router, _ := setupAuthTestServer()
+	reporter := map[string]string{"Authorization": bearerToken(3, time.Now().Add(time.Hour))}
+	member := map[string]string{"Authorization": bearerToken(2, time.Now().Add(time.Hour))}
+
+	recorder := authRequest(router, http.MethodPost, "/issue", `{"title": "로그인 오류", "userId": 2}`, reporter)
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("reporter도 이슈를 등록할 수 있어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var issue model.Issue
+	json.Unmarshal(recorder.Body.Bytes(), &issue)
+	path := fmt.Sprintf("/issue/%d/transitions/", issue.ID)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		path    string
+		reason  string
+	}{
+		{"reporter의 전이", reporter, path + "block", "REPORTER_CREATE_ONLY"},
+		{"member의 취소", member, path + "cancel", "TRANSITION_REQUIRES_ADMIN"},
+	}
+	for _, tt := range tests {
+		recorder := authRequest(router, http.MethodPost, tt.path, "", tt.headers)
+		response := decodeError(t, recorder)
+		if recorder.Code != http.StatusForbidden || response.ErrorCode != "PERMISSION_DENIED" || response.Reason != tt.reason {
+			t.Errorf("%s: 403 %s여야 함. 실제: %d, %s", tt.name, tt.reason, recorder.Code, recorder.Body.String())
+		}
+	}
+
+	recorder = authRequest(router, http.MethodPost, path+"complete", "", member)
+	if recorder.Code != http.StatusOK {
+		t.Errorf("담당자는 완료할 수 있어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+}
+
+func TestCreateIssue_인증된_사용자가_등록자로_기록됨(t *testing.T) {
+	router, _ := setupAuthTestServer()
+
+	recorder := authRequest(router, http.MethodPost, "/issue", `{"title": "로그인 오류", "userId": 2}`, map[string]string{"X-API-Key": "dev-key"})
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("201이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var created model.Issue
+	json.Unmarshal(recorder.Body.Bytes(), &created)
+	if created.Reporter == nil || created.Reporter.ID != 1 || created.User == nil || created.User.ID != 2 {
+		t.Fatalf("등록자는 1번, 담당자는 2번이어야 함. 실제: %s", recorder.Body.String())
+	}
+	if strings.Contains(recorder.Body.String(), "@example.com") {
+		t.Errorf("이슈에 담긴 사용자의 이메일은 응답에 포함되지 않아야 함: %s", recorder.Body.String())
+	}
+	authRequest(router, http.MethodPost, "/issue", `{"title": "다른 사용자의 이슈"}`, map[string]string{"Authorization": bearerToken(3, time.Now().Add(time.Hour))})
+
+	recorder = authRequest(router, http.MethodGet, "/issues?reporterId=1", "", map[string]string{"X-API-Key": "dev-key"})
+	var body struct {
+		Issues []model.Issue `json:"issues"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &body)
+	if len(body.Issues) != 1 || body.Issues[0].ID != created.ID {
+		t.Errorf("1번 사용자가 등록한 이슈 하나만 조회되어야 함. 실제: %s", recorder.Body.String())
+	}
+}
@@ -0,0 +1,83 @@
+package presentation
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/issue/application"
+	"issue-service-aoroa/issue/model"
+	"issue-service-aoroa/issue/query"
+)
+
+var (
+	errUnsupportedMediaType = &apperr.DomainError{
+		Code:    "UNSUPPORTED_MEDIA_TYPE",
+		Message: "지원하지 않는 Content-Type입니다",
+	}
+	errPreconditionFailed = &apperr.DomainError{
+		Code:    "PRECONDITION_FAILED",
+		Message: "If-Match 헤더가 이슈의 현재 버전과 일치하지 않습니다",
+	}
+)
+
+// 이슈 API의 에러를 HTTP 상태 코드와 에러 응답으로 바꾸는 규칙. httpx.ErrorHandler에 넘겨 쓴다
+var ErrorMapping = httpx.ErrorMapping{
+	Status:   statusOf,
+	Messages: errorMessages,
+	Describe: describeSyntaxError,
+}
+
+func statusOf(err error) int {
+	var conflictErr *model.VersionConflictError
+	var syntaxErr *query.SyntaxError
+
+	switch {
+	case errors.Is(err, application.ErrIssueNotFound),
+		errors.Is(err, model.ErrTransitionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrTimeTravelUnsupported):
+		return http.StatusNotImplemented
+	case errors.Is(err, errUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, errPreconditionFailed),
+		errors.Is(err, model.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+	case errors.As(err, &conflictErr),
+		errors.Is(err, model.ErrIssueDeleted),
+		errors.Is(err, model.ErrIssueNotDeleted),
+		errors.Is(err, model.ErrIssueNotClosed):
+		return http.StatusConflict
+	case errors.Is(err, application.ErrUserNotFound),
+		errors.Is(err, model.ErrInvalidStatus),
+		errors.Is(err, model.ErrIssueLocked),
+		errors.Is(err, model.ErrAssigneeRequired),
+		errors.Is(err, application.ErrAssigneeInactive),
+		errors.Is(err, model.ErrTransitionNotAllowed),
+		errors.As(err, &syntaxErr):
+		return http.StatusBadRequest
+	default:
+		return 0
+	}
+}
+
+// 검색 쿼리 에러는 q 필드와 에러 위치를 함께 알려 준다.
+// 상세 메시지는 사유별로 번역하고, 번역이 없으면 파서가 만든 메시지를 그대로 쓴다.
+func describeSyntaxError(err error, language i18n.Language, response *httpx.ErrorResponse) bool {
+	var syntaxErr *query.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return false
+	}
+
+	response.Field = "q"
+	response.Position = &syntaxErr.Position
+	response.Error = syntaxErr.Error()
+	if message, ok := errorMessages.Lookup(query.CodeInvalidQuery+"."+syntaxErr.Reason, language); ok {
+		response.Error = strings.NewReplacer("{position}", strconv.Itoa(syntaxErr.Position), "{token}", syntaxErr.Token).Replace(message)
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/issue/application"
+	"issue-service-aoroa/issue/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func performWithError(err error, acceptLanguage ...string) (*httptest.ResponseRecorder, httpx.ErrorResponse) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(httpx.ErrorHandler(ErrorMapping))
+	router.GET("/", func(ctx *gin.Context) {
+		ctx.Error(err)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, language := range acceptLanguage {
+		request.Header.Add("Accept-Language", language)
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	var response httpx.ErrorResponse
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	return recorder, response
+}
+
+func TestErrorMapping_이슈_에러_상태코드_매핑(t *testing.T) {
+	tests := []struct {
+		err       error
+		status    int
+		errorCode string
+	}{
+		{application.ErrIssueNotFound, http.StatusNotFound, "ISSUE_NOT_FOUND"},
+		{application.ErrUserNotFound, http.StatusBadRequest, "USER_NOT_FOUND"},
+		{model.ErrInvalidStatus, http.StatusBadRequest, "INVALID_STATUS"},
+		{model.ErrIssueLocked, http.StatusBadRequest, "ISSUE_LOCKED"},
+		{model.ErrAssigneeRequired, http.StatusBadRequest, "ASSIGNEE_REQUIRED"},
+		{application.ErrAssigneeInactive, http.StatusBadRequest, "ASSIGNEE_INACTIVE"},
+		{model.ErrTransitionNotAllowed, http.StatusBadRequest, "TRANSITION_NOT_ALLOWED"},
+		{model.ErrTransitionNotFound, http.StatusNotFound, "TRANSITION_NOT_FOUND"},
+		{application.ErrTimeTravelUnsupported, http.StatusNotImplemented, "TIME_TRAVEL_UNSUPPORTED"},
+		{application.ErrTransitionRequiresAdmin, http.StatusForbidden, "PERMISSION_DENIED"},
+		{&apperr.ValidationError{Field: "title", Message: "제목은 필수입니다"}, http.StatusBadRequest, "VALIDATION_FAILED"},
+		{&model.VersionConflictError{IssueID: 1, ExpectedVersion: 1, CurrentVersion: 2}, http.StatusConflict, "VERSION_CONFLICT"},
+		{errPreconditionFailed, http.StatusPreconditionFailed, "PRECONDITION_FAILED"},
+		{fmt.Errorf("감싼 에러: %w", application.ErrIssueNotFound), http.StatusNotFound, "ISSUE_NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		recorder, response := performWithError(tt.err)
+
+		if recorder.Code != tt.status {
+			t.Errorf("%v: 예상 상태 코드 %d, 실제 %d", tt.err, tt.status, recorder.Code)
+		}
+		if response.ErrorCode != tt.errorCode {
+			t.Errorf("%v: 예상 에러 코드 %s, 실제 %s", tt.err, tt.errorCode, response.ErrorCode)
+		}
+	}
+}
+
+func TestErrorMapping_권한_에러_사유_포함(t *testing.T) {
+	_, response := performWithError(application.ErrTransitionRequiresAssignee, "en")
+
+	if response.Reason != "TRANSITION_REQUIRES_ASSIGNEE" {
+		t.Errorf("reason이 포함되어야 함. 실제: %s", response.Reason)
+	}
+	if response.Error != "Only the assignee or an administrator can make this status change" {
+		t.Errorf("사유별 영어 메시지여야 함. 실제: %s", response.Error)
+	}
+}
+
+func TestErrorMapping_Accept_Language_영어_메시지(t *testing.T) {
+	recorder, response := performWithError(application.ErrIssueNotFound, "en-US,en;q=0.9")
+
+	if response.Error != "Issue not found" {
+		t.Errorf("영어 메시지여야 함. 실제: %s", response.Error)
+	}
+	if response.ErrorCode != "ISSUE_NOT_FOUND" {
+		t.Errorf("에러 코드는 언어와 무관해야 함. 실제: %s", response.ErrorCode)
+	}
+	if recorder.Header().Get("Content-Language") != "en" {
+		t.Errorf("Content-Language는 en이어야 함. 실제: %s", recorder.Header().Get("Content-Language"))
+	}
+}
+
+func TestErrorMapping_검증_에러_필드별_영어_메시지(t *testing.T) {
+	_, response := performWithError(&apperr.ValidationError{Field: "title", Message: "제목은 필수입니다"}, "en")
+
+	if response.Error != "Title is required" {
+		t.Errorf("필드별 영어 메시지여야 함. 실제: %s", response.Error)
+	}
+}
+
+func TestErrorMapping_검증_에러는_필드가_아닌_사유로_번역(t *testing.T) {
+	tests := []struct {
+		err  *apperr.ValidationError
+		want string
+	}{
+		{&apperr.ValidationError{Field: "q", Reason: "ORDER_BY_WITH_TEXT_SEARCH", Message: "ORDER BY 불가"}, "ORDER BY cannot be used with text search because results are ranked by relevance"},
+		{&apperr.ValidationError{Field: "status", Reason: "NULL_STATUS", Message: "null 불가"}, "Status cannot be null"},
+		{&apperr.ValidationError{Field: "q", Message: "다른 q 에러"}, "다른 q 에러"},
+		{&apperr.ValidationError{Field: "status", Reason: reasonTypeMismatch, Message: "형식 오류"}, "Field status has an invalid type"},
+	}
+
+	for _, tt := range tests {
+		if _, response := performWithError(tt.err, "en"); response.Error != tt.want {
+			t.Errorf("%+v: 예상 %q, 실제 %q", tt.err, tt.want, response.Error)
+		}
+	}
+}
+
+func TestErrorMapping_모든_이슈_에러_코드에_영어_메시지_존재(t *testing.T) {
+	for code, messages := range errorMessages {
+		if _, ok := messages[i18n.English]; !ok {
+			t.Errorf("%s에 영어 메시지가 없음", code)
+		}
+		if _, ok := messages[i18n.Korean]; !ok {
+			t.Errorf("%s에 한국어 메시지가 없음", code)
+		}
+	}
+}
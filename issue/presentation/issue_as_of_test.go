@@ -0,0 +1,75 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+)
+
+func setupEventLogServer(t *testing.T) *testServer {
+	t.Helper()
+	log, err := infrastructure.OpenFileEventLog(filepath.Join(t.TempDir(), "events.log"))
+	if err != nil {
+		t.Fatalf("이벤트 로그 열기 실패: %v", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	issueRepo, err := infrastructure.NewEventSourcedIssueRepository(log)
+	if err != nil {
+		t.Fatalf("이슈 저장소 생성 실패: %v", err)
+	}
+	return newTestServer(issueRepo)
+}
+
+func asOfPath(id uint, at time.Time) string {
+	return fmt.Sprintf("/issue/%d?asOf=%s", id, url.QueryEscape(at.Format(time.RFC3339Nano)))
+}
+
+func TestGetIssueByID_asOf_과거_시점의_상태(t *testing.T) {
+	server := setupEventLogServer(t)
+	issue := server.createIssue(t, nil)
+	time.Sleep(time.Millisecond)
+	beforeCancel := time.Now()
+	time.Sleep(time.Millisecond)
+	server.request(http.MethodPost, fmt.Sprintf("/issue/%d/transitions/cancel", issue.ID), nil)
+
+	recorder := server.get(asOfPath(issue.ID, beforeCancel))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	if recorder.Header().Get("ETag") != "" {
+		t.Error("과거 시점 조회에는 ETag가 없어야 함")
+	}
+	var past model.Issue
+	json.Unmarshal(recorder.Body.Bytes(), &past)
+	if past.Status != model.StatusPending || past.Version != 1 {
+		t.Errorf("취소 전 상태여야 함. 실제: %s, 버전 %d", past.Status, past.Version)
+	}
+
+	recorder = server.get(asOfPath(issue.ID, issue.CreatedAt.Add(-time.Second)))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("생성 전 시점은 404여야 함. 실제: %d", recorder.Code)
+	}
+}
+
+func TestGetIssueByID_asOf_실패(t *testing.T) {
+	server := setupTestServer()
+	issue := server.createIssue(t, nil)
+
+	recorder := server.get(fmt.Sprintf("/issue/%d?asOf=yesterday", issue.ID))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("잘못된 시각은 400이어야 함. 실제: %d", recorder.Code)
+	}
+
+	recorder = server.get(asOfPath(issue.ID, time.Now()))
+	if recorder.Code != http.StatusNotImplemented {
+		t.Errorf("이벤트 로그 저장소가 아니면 501이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+}
@@ -1,14 +1,28 @@
 package presentation
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/internal/httpx"
 	"issue-service-aoroa/issue/application"
+	"issue-service-aoroa/issue/model"
 
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	mimeMergePatch = "application/merge-patch+json"
+	mimeJSONPatch  = "application/json-patch+json"
+)
+
 type IssueController struct {
 	issueService application.IssueService
 }
@@ -19,11 +33,6 @@ type CreateIssueRequest struct {
 	UserID      *uint  `json:"userId"`
 }
 
-type ErrorResponse struct {
-	Error string `json:"error"`
-	Code  int    `json:"code"`
-}
-
 func NewIssueController(issueService application.IssueService) *IssueController {
 	return &IssueController{
 		issueService: issueService,
@@ -33,33 +42,13 @@ func NewIssueController(issueService application.IssueService) *IssueController
 func (c *IssueController) CreateIssue(ctx *gin.Context) {
 	var req CreateIssueRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "잘못된 요청 데이터입니다",
-			Code:  http.StatusBadRequest,
-		})
+		ctx.Error(httpx.ErrInvalidRequest)
 		return
 	}
 
-	issue, err := c.issueService.CreateIssue(req.Title, req.Description, req.UserID)
+	issue, err := c.auditedService(ctx).CreateIssue(req.Title, req.Description, req.UserID)
 	if err != nil {
-		if err.Error() == "사용자를 찾을 수 없습니다" {
-			ctx.JSON(http.StatusBadRequest, ErrorResponse{
-				Error: "사용자를 찾을 수 없습니다",
-				Code:  http.StatusBadRequest,
-			})
-			return
-		}
-		if err.Error() == "제목은 필수입니다" {
-			ctx.JSON(http.StatusBadRequest, ErrorResponse{
-				Error: "제목은 필수입니다",
-				Code:  http.StatusBadRequest,
-			})
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error: "서버 내부 오류입니다",
-			Code:  http.StatusInternalServerError,
-		})
+		ctx.Error(err)
 		return
 	}
 
@@ -67,50 +56,132 @@ func (c *IssueController) CreateIssue(ctx *gin.Context) {
 }
 
 func (c *IssueController) GetIssues(ctx *gin.Context) {
-	status := ctx.Query("status")
-	
-	if status != "" {
-		issues, err := c.issueService.GetIssuesByStatus(status)
+	query, err := bindListIssuesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	list, err := c.issueService.ListIssues(query)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"issues":     list.Issues,
+		"nextCursor": list.NextCursor,
+		"total":      list.Total,
+	})
+}
+
+type TextSearchResultResponse struct {
+	Issue      model.Issue       `json:"issue"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights"`
+}
+
+// text 파라미터가 있으면 관련도순 전문 검색을, 없으면 검색 쿼리(q) 결과를 반환한다
+func (c *IssueController) SearchIssues(ctx *gin.Context) {
+	var limit int
+	if raw := ctx.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, ErrorResponse{
-				Error: "유효하지 않은 상태입니다",
-				Code:  http.StatusBadRequest,
-			})
+			ctx.Error(queryTypeMismatch("limit"))
 			return
 		}
-		ctx.JSON(http.StatusOK, gin.H{"issues": issues})
+		limit = parsed
+	}
+
+	includeDeleted, fieldErr := boolQuery(ctx, "includeDeleted")
+	if fieldErr != nil {
+		ctx.Error(fieldErr)
 		return
 	}
 
-	issues := c.issueService.GetAllIssues()
-	ctx.JSON(http.StatusOK, gin.H{"issues": issues})
+	if text, ok := ctx.GetQuery("text"); ok {
+		c.searchIssuesByText(ctx, application.TextSearchQuery{
+			Text:           text,
+			Query:          ctx.Query("q"),
+			Limit:          limit,
+			IncludeDeleted: includeDeleted,
+		})
+		return
+	}
+
+	list, err := c.issueService.SearchIssues(application.SearchIssuesQuery{
+		Query:          ctx.Query("q"),
+		Limit:          limit,
+		Cursor:         ctx.Query("cursor"),
+		IncludeDeleted: includeDeleted,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"issues":     list.Issues,
+		"nextCursor": list.NextCursor,
+		"total":      list.Total,
+	})
 }
 
-func (c *IssueController) GetIssueByID(ctx *gin.Context) {
-	idStr := ctx.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 32)
+func (c *IssueController) searchIssuesByText(ctx *gin.Context, textQuery application.TextSearchQuery) {
+	found, err := c.issueService.SearchIssuesByText(textQuery)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "잘못된 ID 형식입니다",
-			Code:  http.StatusBadRequest,
+		ctx.Error(err)
+		return
+	}
+
+	results := make([]TextSearchResultResponse, 0, len(found.Results))
+	for _, result := range found.Results {
+		results = append(results, TextSearchResultResponse{
+			Issue:      result.Issue,
+			Score:      result.Score,
+			Highlights: result.Highlights,
 		})
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"results": results,
+		"total":   found.Total,
+	})
+}
+
+func (c *IssueController) GetIssueByID(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if value, ok := ctx.GetQuery("asOf"); ok {
+		c.getIssueAsOf(ctx, id, value)
 		return
 	}
 
-	issue, err := c.issueService.GetIssueByID(uint(id))
+	issue, err := c.issueService.GetIssueByID(id)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error: "서버 내부 오류입니다",
-			Code:  http.StatusInternalServerError,
-		})
+		ctx.Error(err)
 		return
 	}
 
-	if issue == nil {
-		ctx.JSON(http.StatusNotFound, ErrorResponse{
-			Error: "이슈를 찾을 수 없습니다",
-			Code:  http.StatusNotFound,
-		})
+	ctx.Header("ETag", issueETag(issue))
+	ctx.JSON(http.StatusOK, issue)
+}
+
+// 과거 시점의 상태는 수정 조건으로 쓸 수 없으므로 ETag를 보내지 않는다
+func (c *IssueController) getIssueAsOf(ctx *gin.Context, id uint, value string) {
+	asOf, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		ctx.Error(&apperr.ValidationError{Field: "asOf", Message: "asOf는 RFC 3339 형식의 시각이어야 합니다"})
+		return
+	}
+
+	issue, err := c.issueService.GetIssueAsOf(id, asOf)
+	if err != nil {
+		ctx.Error(err)
 		return
 	}
 
@@ -118,85 +189,318 @@ func (c *IssueController) GetIssueByID(ctx *gin.Context) {
 }
 
 func (c *IssueController) UpdateIssue(ctx *gin.Context) {
-	idStr := ctx.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 32)
+	id, err := parseID(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "잘못된 ID 형식입니다",
-			Code:  http.StatusBadRequest,
-		})
+		ctx.Error(err)
 		return
 	}
 
-	var rawRequest map[string]interface{}
-	if err := ctx.ShouldBindJSON(&rawRequest); err != nil {
-		ctx.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "잘못된 요청 데이터입니다",
-			Code:  http.StatusBadRequest,
-		})
+	expectedVersion, ok := parseIfMatch(ctx.GetHeader("If-Match"))
+	if !ok {
+		ctx.Error(errPreconditionFailed)
 		return
 	}
 
-	updates := make(map[string]interface{})
-	hasStatusUpdate := false
-	
-	if title, ok := rawRequest["title"]; ok {
-		updates["title"] = title
-	}
-	if description, ok := rawRequest["description"]; ok {
-		updates["description"] = description
-	}
-	if status, ok := rawRequest["status"]; ok {
-		updates["status"] = status
-		hasStatusUpdate = true
-	}
-	if userID, ok := rawRequest["userId"]; ok {
-		updates["userId"] = userID
-	}
-	
-	updates["status"] = hasStatusUpdate
-
-	issue, err := c.issueService.UpdateIssue(uint(id), updates)
-	if err != nil {
-		switch err.Error() {
-		case "이슈를 찾을 수 없습니다":
-			ctx.JSON(http.StatusNotFound, ErrorResponse{
-				Error: "이슈를 찾을 수 없습니다",
-				Code:  http.StatusNotFound,
-			})
-		case "완료되거나 취소된 이슈는 수정할 수 없습니다":
-			ctx.JSON(http.StatusBadRequest, ErrorResponse{
-				Error: "완료되거나 취소된 이슈는 수정할 수 없습니다",
-				Code:  http.StatusBadRequest,
-			})
-		case "사용자를 찾을 수 없습니다":
-			ctx.JSON(http.StatusBadRequest, ErrorResponse{
-				Error: "사용자를 찾을 수 없습니다",
-				Code:  http.StatusBadRequest,
-			})
-		case "유효하지 않은 상태입니다":
-			ctx.JSON(http.StatusBadRequest, ErrorResponse{
-				Error: "유효하지 않은 상태입니다",
-				Code:  http.StatusBadRequest,
-			})
-		case "담당자 없이는 진행중 또는 완료 상태로 변경할 수 없습니다":
-			ctx.JSON(http.StatusBadRequest, ErrorResponse{
-				Error: "담당자 없이는 진행중 또는 완료 상태로 변경할 수 없습니다",
-				Code:  http.StatusBadRequest,
-			})
-		case "제목은 필수입니다":
-			ctx.JSON(http.StatusBadRequest, ErrorResponse{
-				Error: "제목은 필수입니다",
-				Code:  http.StatusBadRequest,
-			})
-		default:
-			ctx.JSON(http.StatusInternalServerError, ErrorResponse{
-				Error: "서버 내부 오류입니다",
-				Code:  http.StatusInternalServerError,
-			})
-		}
+	operations, err := bindPatchOperations(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	issue, err := c.auditedService(ctx).PatchIssue(id, operations, expectedVersion)
+	if err != nil {
+		ctx.Error(preconditionError(err, expectedVersion))
 		return
 	}
 
+	ctx.Header("ETag", issueETag(issue))
 	ctx.JSON(http.StatusOK, issue)
-}
\ No newline at end of file
+}
+
+func (c *IssueController) DeleteIssue(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	expectedVersion, ok := parseIfMatch(ctx.GetHeader("If-Match"))
+	if !ok {
+		ctx.Error(errPreconditionFailed)
+		return
+	}
+
+	if err := c.auditedService(ctx).DeleteIssue(id, expectedVersion); err != nil {
+		ctx.Error(preconditionError(err, expectedVersion))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *IssueController) RestoreIssue(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	expectedVersion, ok := parseIfMatch(ctx.GetHeader("If-Match"))
+	if !ok {
+		ctx.Error(errPreconditionFailed)
+		return
+	}
+
+	issue, err := c.auditedService(ctx).RestoreIssue(id, expectedVersion)
+	if err != nil {
+		ctx.Error(preconditionError(err, expectedVersion))
+		return
+	}
+
+	ctx.Header("ETag", issueETag(issue))
+	ctx.JSON(http.StatusOK, issue)
+}
+
+type TransitionResponse struct {
+	Name string `json:"name"`
+	To   string `json:"to"`
+}
+
+type TransitionIssueRequest struct {
+	Comment string `json:"comment"`
+}
+
+type TransitionResultResponse struct {
+	Issue      *model.Issue `json:"issue"`
+	Transition string       `json:"transition"`
+	From       string       `json:"from"`
+	To         string       `json:"to"`
+	Comment    string       `json:"comment,omitempty"`
+}
+
+// 현재 상태에서 요청한 사용자가 실행할 수 있는 전이만 반환하므로 클라이언트는 이 목록대로 버튼을 그리면 된다
+func (c *IssueController) GetIssueTransitions(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	found, err := c.auditedService(ctx).GetIssueTransitions(id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	transitions := make([]TransitionResponse, 0, len(found.Transitions))
+	for _, transition := range found.Transitions {
+		transitions = append(transitions, TransitionResponse{Name: transition.Name, To: transition.To})
+	}
+
+	ctx.Header("ETag", issueETag(found.Issue))
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":      found.Issue.Status,
+		"transitions": transitions,
+	})
+}
+
+// 요청 본문은 생략할 수 있으며, 있으면 {"comment": "..."} 형식이다
+func (c *IssueController) TransitionIssue(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	expectedVersion, ok := parseIfMatch(ctx.GetHeader("If-Match"))
+	if !ok {
+		ctx.Error(errPreconditionFailed)
+		return
+	}
+
+	req, err := bindTransitionRequest(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	result, err := c.auditedService(ctx).TransitionIssue(id, application.TransitionIssueCommand{
+		Name:    ctx.Param("name"),
+		Comment: req.Comment,
+	}, expectedVersion)
+	if err != nil {
+		ctx.Error(preconditionError(err, expectedVersion))
+		return
+	}
+
+	ctx.Header("ETag", issueETag(result.Issue))
+	ctx.JSON(http.StatusOK, TransitionResultResponse{
+		Issue:      result.Issue,
+		Transition: result.Transition.Name,
+		From:       result.From,
+		To:         result.Transition.To,
+		Comment:    result.Comment,
+	})
+}
+
+func bindTransitionRequest(ctx *gin.Context) (TransitionIssueRequest, error) {
+	var req TransitionIssueRequest
+
+	body, err := ctx.GetRawData()
+	if err != nil {
+		return req, httpx.ErrInvalidRequest
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return req, nil
+	}
+
+	switch ctx.ContentType() {
+	case "", gin.MIMEJSON:
+	default:
+		return req, errUnsupportedMediaType
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return req, httpx.ErrInvalidRequest
+	}
+	return req, nil
+}
+
+type ReopenIssueRequest struct {
+	Reason string `json:"reason"`
+}
+
+// 관리자 전용. 종료된 이슈를 다시 열고, 인증된 사용자를 다시 연 사용자로 사유와 함께 기록한다.
+func (c *IssueController) ReopenIssue(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	expectedVersion, ok := parseIfMatch(ctx.GetHeader("If-Match"))
+	if !ok {
+		ctx.Error(errPreconditionFailed)
+		return
+	}
+
+	var req ReopenIssueRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(httpx.ErrInvalidRequest)
+		return
+	}
+
+	issue, err := c.auditedService(ctx).ReopenIssue(id, application.ReopenIssueCommand{Reason: req.Reason}, expectedVersion)
+	if err != nil {
+		ctx.Error(preconditionError(err, expectedVersion))
+		return
+	}
+
+	ctx.Header("ETag", issueETag(issue))
+	ctx.JSON(http.StatusOK, issue)
+}
+
+func (c *IssueController) GetIssueHistory(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	history, err := c.issueService.GetIssueHistory(id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"history": history})
+}
+
+// 관리자 전용. 삭제된 이슈를 저장소에서 완전히 지운다.
+func (c *IssueController) PurgeIssue(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if err := c.auditedService(ctx).PurgeIssue(id); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// 이슈를 변경하는 요청은 인증된 사용자와 요청 ID를 변경 이력에 남긴다
+func (c *IssueController) auditedService(ctx *gin.Context) application.IssueService {
+	return c.issueService.WithAudit(application.AuditContext{
+		Actor:     httpx.AuthenticatedUser(ctx),
+		RequestID: httpx.RequestIDOf(ctx),
+	})
+}
+
+// If-Match로 버전을 지정한 요청의 버전 충돌은 412로 응답한다
+func preconditionError(err error, expectedVersion *uint) error {
+	var conflictErr *model.VersionConflictError
+	if expectedVersion != nil && errors.As(err, &conflictErr) {
+		return errPreconditionFailed
+	}
+	return err
+}
+
+// Content-Type에 따라 JSON Merge Patch(RFC 7386) 또는 JSON Patch(RFC 6902)로 해석한다
+func bindPatchOperations(ctx *gin.Context) ([]application.PatchOperation, error) {
+	contentType := ctx.ContentType()
+	switch contentType {
+	case "", gin.MIMEJSON, mimeMergePatch, mimeJSONPatch:
+	default:
+		return nil, errUnsupportedMediaType
+	}
+
+	body, err := ctx.GetRawData()
+	if err != nil {
+		return nil, httpx.ErrInvalidRequest
+	}
+
+	if contentType == mimeJSONPatch {
+		return decodeJSONPatch(body)
+	}
+
+	cmd, err := decodeMergePatch(body)
+	if err != nil {
+		return nil, err
+	}
+	return []application.PatchOperation{{Command: cmd}}, nil
+}
+
+func parseID(ctx *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return 0, httpx.ErrInvalidID
+	}
+	return uint(id), nil
+}
+
+func issueETag(issue *model.Issue) string {
+	return fmt.Sprintf("\"%d\"", issue.Version)
+}
+
+// If-Match 헤더를 기대 버전으로 변환한다. 헤더가 없거나 "*"이면 버전을 검사하지 않는다.
+// 약한 ETag나 해석할 수 없는 값은 어떤 버전과도 일치하지 않으므로 ok=false를 반환한다.
+func parseIfMatch(header string) (*uint, bool) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, true
+	}
+
+	if len(header) < 2 || !strings.HasPrefix(header, "\"") || !strings.HasSuffix(header, "\"") {
+		return nil, false
+	}
+	version, err := strconv.ParseUint(header[1:len(header)-1], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	expected := uint(version)
+	return &expected, true
+}
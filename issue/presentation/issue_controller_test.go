@@ -0,0 +1,243 @@
+package presentation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/issue/application"
+	"issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/issue/model"
+	mentionApp "issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	userApp "issue-service-aoroa/user/application"
+	userInfra "issue-service-aoroa/user/infrastructure"
+
+	"github.com/gin-gonic/gin"
+)
+
+type testServer struct {
+	router  *gin.Engine
+	service application.IssueService
+}
+
+func setupTestServer() *testServer {
+	return newTestServer(infrastructure.NewIssueRepository())
+}
+
+func newTestServer(issueRepo infrastructure.IssueRepository) *testServer {
+	gin.SetMode(gin.TestMode)
+
+	userRepo := userInfra.NewUserRepository()
+	mentions := mentionApp.NewMentionService(mentionInfra.NewMentionRepository(), issueRepo, userRepo)
+	service := application.NewIssueService(issueRepo, userRepo, commentInfra.NewCommentRepository(), mentions)
+	controller := NewIssueController(service)
+	authenticator := userApp.NewAuthenticator(userRepo, userApp.AuthConfig{
+		APIKeys: map[string]uint{"dev-key": 1},
+	})
+
+	router := gin.New()
+	router.Use(httpx.RequestID())
+	router.Use(httpx.ErrorHandler(ErrorMapping))
+	router.Use(asAdminByDefault, httpx.Authenticate(authenticator))
+	router.POST("/issue", controller.CreateIssue)
+	router.GET("/issue/:id", controller.GetIssueByID)
+	router.GET("/issues", controller.GetIssues)
+	router.GET("/issues/search", controller.SearchIssues)
+	router.PATCH("/issue/:id", controller.UpdateIssue)
+	router.DELETE("/issue/:id", controller.DeleteIssue)
+	router.POST("/issue/:id/restore", controller.RestoreIssue)
+	router.GET("/issue/:id/transitions", controller.GetIssueTransitions)
+	router.GET("/issue/:id/history", controller.GetIssueHistory)
+	router.POST("/issue/:id/transitions/:name", controller.TransitionIssue)
+	router.DELETE("/admin/issue/:id", controller.PurgeIssue)
+	router.POST("/admin/issue/:id/reopen", controller.ReopenIssue)
+
+	admin, _ := userRepo.GetByID(1)
+	return &testServer{router: router, service: service.WithAudit(application.AuditContext{Actor: admin})}
+}
+
+// 인증 헤더 없이 보낸 요청은 관리자인 1번 사용자의 API 키로 인증한다
+func asAdminByDefault(ctx *gin.Context) {
+	if ctx.GetHeader(httpx.APIKeyHeader) == "" && ctx.GetHeader("Authorization") == "" {
+		ctx.Request.Header.Set(httpx.APIKeyHeader, "dev-key")
+	}
+}
+
+func (s *testServer) patch(path, contentType, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(body))
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	recorder := httptest.NewRecorder()
+	s.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (s *testServer) request(method, path string, headers map[string]string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, nil)
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	recorder := httptest.NewRecorder()
+	s.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (s *testServer) createIssue(t *testing.T, userID *uint) *model.Issue {
+	issue, err := s.service.CreateIssue("테스트 이슈", "설명", userID)
+	if err != nil {
+		t.Fatalf("이슈 생성 실패: %v", err)
+	}
+	return issue
+}
+
+func decodeIssue(t *testing.T, recorder *httptest.ResponseRecorder) model.Issue {
+	var issue model.Issue
+	if err := json.Unmarshal(recorder.Body.Bytes(), &issue); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	return issue
+}
+
+func decodeError(t *testing.T, recorder *httptest.ResponseRecorder) httpx.ErrorResponse {
+	var response httpx.ErrorResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	return response
+}
+
+func TestUpdateIssue_성공_상태_변경_반영(t *testing.T) {
+	server := setupTestServer()
+	userID := uint(1)
+	issue := server.createIssue(t, &userID)
+
+	recorder := server.patch("/issue/1", "application/json", `{"status": "COMPLETED"}`)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	if updated := decodeIssue(t, recorder); updated.Status != model.StatusCompleted {
+		t.Errorf("상태가 COMPLETED로 변경되어야 함. 실제: %s", updated.Status)
+	}
+	if issue.Status != model.StatusInProgress {
+		t.Errorf("생성 직후 상태는 IN_PROGRESS여야 함. 실제: %s", issue.Status)
+	}
+}
+
+func TestUpdateIssue_성공_Merge_Patch_명시되지_않은_필드_유지(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", mimeMergePatch, `{"title": "새 제목"}`)
+
+	updated := decodeIssue(t, recorder)
+	if updated.Title != "새 제목" {
+		t.Errorf("제목이 변경되어야 함. 실제: %s", updated.Title)
+	}
+	if updated.Description != "설명" {
+		t.Errorf("설명은 유지되어야 함. 실제: %s", updated.Description)
+	}
+}
+
+func TestUpdateIssue_성공_null로_담당자_제거_설명_삭제(t *testing.T) {
+	server := setupTestServer()
+	userID := uint(1)
+	server.createIssue(t, &userID)
+
+	recorder := server.patch("/issue/1", mimeMergePatch, `{"userId": null, "description": null}`)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	updated := decodeIssue(t, recorder)
+	if updated.User != nil || updated.Status != model.StatusPending {
+		t.Errorf("담당자가 제거되고 PENDING이어야 함. 실제: %+v", updated)
+	}
+	if updated.Description != "" {
+		t.Errorf("설명이 비워져야 함. 실제: %s", updated.Description)
+	}
+}
+
+func TestUpdateIssue_실패_필드_타입_불일치(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", "application/json", `{"title": 5, "userId": "2"}`)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("400이어야 함. 실제: %d", recorder.Code)
+	}
+	response := decodeError(t, recorder)
+	if response.ErrorCode != apperr.CodeValidationFailed {
+		t.Errorf("VALIDATION_FAILED여야 함. 실제: %s", response.ErrorCode)
+	}
+	if len(response.Fields) != 2 {
+		t.Fatalf("필드 에러가 2개여야 함. 실제: %+v", response.Fields)
+	}
+	for _, field := range response.Fields {
+		if field.Reason != reasonTypeMismatch {
+			t.Errorf("%s 필드는 TYPE_MISMATCH여야 함. 실제: %s", field.Field, field.Reason)
+		}
+	}
+
+	stored, _ := server.service.GetIssueByID(1)
+	if stored.Title != "테스트 이슈" {
+		t.Error("검증 실패 시 이슈가 변경되면 안 됨")
+	}
+}
+
+func TestUpdateIssue_실패_알_수_없는_필드(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", "application/json", `{"title": "새 제목", "priority": "HIGH"}`)
+
+	response := decodeError(t, recorder)
+	if recorder.Code != http.StatusBadRequest || len(response.Fields) != 1 {
+		t.Fatalf("알 수 없는 필드 하나에 대한 400이어야 함. 실제: %d, %+v", recorder.Code, response)
+	}
+	if response.Fields[0].Field != "priority" || response.Fields[0].Reason != reasonUnknownField {
+		t.Errorf("priority 필드의 UNKNOWN_FIELD여야 함. 실제: %+v", response.Fields[0])
+	}
+}
+
+func TestUpdateIssue_실패_제목_null(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", mimeMergePatch, `{"title": null}`)
+
+	response := decodeError(t, recorder)
+	if recorder.Code != http.StatusBadRequest || response.Field != "title" {
+		t.Errorf("title 필드에 대한 400이어야 함. 실제: %d, %+v", recorder.Code, response)
+	}
+}
+
+func TestUpdateIssue_실패_객체가_아닌_문서(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", mimeMergePatch, `["title"]`)
+
+	if response := decodeError(t, recorder); response.ErrorCode != "INVALID_REQUEST" {
+		t.Errorf("INVALID_REQUEST여야 함. 실제: %s", response.ErrorCode)
+	}
+}
+
+func TestUpdateIssue_실패_지원하지_않는_Content_Type(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", "text/plain", `{"title": "새 제목"}`)
+
+	if recorder.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("415여야 함. 실제: %d", recorder.Code)
+	}
+}
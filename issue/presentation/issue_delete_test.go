@@ -0,0 +1,66 @@
+package presentation
+
+import (
+	"fmt"
+	"issue-service-aoroa/internal/httpx"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDeleteIssue_삭제_후_복원(t *testing.T) {
+	server := setupTestServer()
+	issue := server.createIssue(t, nil)
+	path := fmt.Sprintf("/issue/%d", issue.ID)
+
+	recorder := server.request(http.MethodDelete, path, nil)
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("204여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	if body := server.get("/issues").Body.String(); body != `{"issues":[],"nextCursor":null,"total":0}` {
+		t.Errorf("삭제된 이슈는 목록에서 제외되어야 함. 실제: %s", body)
+	}
+	if recorder := server.get("/issues?includeDeleted=true"); decodeTotal(t, recorder) != 1 {
+		t.Errorf("includeDeleted=true이면 포함되어야 함. 실제: %s", recorder.Body.String())
+	}
+
+	recorder = server.patch(path, "", `{"title":"새 제목"}`)
+	if recorder.Code != http.StatusConflict || decodeError(t, recorder).ErrorCode != "ISSUE_DELETED" {
+		t.Errorf("삭제된 이슈 수정은 409 ISSUE_DELETED여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = server.request(http.MethodPost, path+"/restore", map[string]string{"If-Match": `"2"`})
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	if restored := decodeIssue(t, recorder); restored.DeletedAt != nil || recorder.Header().Get("ETag") != `"3"` {
+		t.Errorf("복원된 이슈와 새 ETag를 반환해야 함. 실제: %s, ETag %s", recorder.Body.String(), recorder.Header().Get("ETag"))
+	}
+
+	recorder = server.request(http.MethodPost, path+"/restore", nil)
+	if recorder.Code != http.StatusConflict || decodeError(t, recorder).ErrorCode != "ISSUE_NOT_DELETED" {
+		t.Errorf("삭제되지 않은 이슈 복원은 409 ISSUE_NOT_DELETED여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+}
+
+func TestPurgeIssue_관리자_역할_필요(t *testing.T) {
+	router, _ := setupAuthTestServer()
+	admin := map[string]string{httpx.APIKeyHeader: "dev-key"}
+	issue := createIssueAs(t, router, admin)
+	authRequest(router, http.MethodDelete, fmt.Sprintf("/issue/%d", issue.ID), "", admin)
+	path := fmt.Sprintf("/admin/issue/%d", issue.ID)
+
+	recorder := authRequest(router, http.MethodDelete, path, "", map[string]string{"Authorization": bearerToken(2, time.Now().Add(time.Hour))})
+	if response := decodeError(t, recorder); recorder.Code != http.StatusForbidden || response.Reason != "ADMIN_ONLY" {
+		t.Errorf("member: 403 ADMIN_ONLY여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = authRequest(router, http.MethodDelete, path, "", admin)
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("204여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	if recorder := authRequest(router, http.MethodDelete, path, "", admin); recorder.Code != http.StatusNotFound {
+		t.Errorf("완전 삭제된 이슈는 404여야 함. 실제: %d", recorder.Code)
+	}
+}
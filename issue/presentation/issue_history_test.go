@@ -0,0 +1,56 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/issue/model"
+)
+
+func TestGetIssueHistory_요청_ID가_기록됨(t *testing.T) {
+	server := setupTestServer()
+	issue := server.createIssue(t, nil)
+	path := fmt.Sprintf("/issue/%d", issue.ID)
+
+	recorder := server.request(http.MethodPost, path+"/transitions/cancel", map[string]string{httpx.RequestIDHeader: "trace-42"})
+	if recorder.Header().Get(httpx.RequestIDHeader) != "trace-42" {
+		t.Errorf("받은 요청 ID를 응답 헤더로 돌려줘야 함. 실제: %q", recorder.Header().Get(httpx.RequestIDHeader))
+	}
+
+	recorder = server.get(path + "/history")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var response struct {
+		History []model.HistoryEntry `json:"history"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	last := response.History[len(response.History)-1]
+	if last.Action != model.HistoryTransitioned || *last.NewValue != model.StatusCancelled || last.RequestID != "trace-42" {
+		t.Errorf("전이 이력에 요청 ID가 남아야 함. 실제: %s", recorder.Body.String())
+	}
+}
+
+func TestRequestID_없거나_잘못된_값이면_새로_만듦(t *testing.T) {
+	server := setupTestServer()
+
+	for _, header := range []string{"", "공백 포함 ID"} {
+		recorder := server.request(http.MethodGet, "/issues", map[string]string{httpx.RequestIDHeader: header})
+		if id := recorder.Header().Get(httpx.RequestIDHeader); len(id) != 32 {
+			t.Errorf("%q: 새 요청 ID를 만들어야 함. 실제: %q", header, id)
+		}
+	}
+}
+
+func TestGetIssueHistory_실패_존재하지_않는_이슈(t *testing.T) {
+	server := setupTestServer()
+
+	recorder := server.get("/issue/999/history")
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("404여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+}
@@ -0,0 +1,64 @@
+package presentation
+
+import (
+	"fmt"
+	"issue-service-aoroa/internal/httpx"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReopenIssue_관리자만_다시_열_수_있음(t *testing.T) {
+	router, _ := setupAuthTestServer()
+	admin := map[string]string{httpx.APIKeyHeader: "dev-key"}
+	issue := createIssueAs(t, router, admin)
+	authRequest(router, http.MethodPost, fmt.Sprintf("/issue/%d/transitions/cancel", issue.ID), "", admin)
+	path := fmt.Sprintf("/admin/issue/%d/reopen", issue.ID)
+	body := `{"reason":"중복이 아니었음"}`
+
+	recorder := authRequest(router, http.MethodPost, path, body, map[string]string{"Authorization": bearerToken(2, time.Now().Add(time.Hour))})
+	if response := decodeError(t, recorder); recorder.Code != http.StatusForbidden || response.Reason != "ADMIN_ONLY" {
+		t.Errorf("member: 403 ADMIN_ONLY여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = authRequest(router, http.MethodPost, path, body, admin)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	reopened := decodeIssue(t, recorder)
+	if reopened.Status != "PENDING" || reopened.ReopenCount != 1 || reopened.LastReopen.By.ID != 1 {
+		t.Errorf("인증된 사용자가 다시 연 사용자로 기록되어야 함. 실제: %s", recorder.Body.String())
+	}
+
+	recorder = authRequest(router, http.MethodPost, path, body, admin)
+	if recorder.Code != http.StatusConflict || decodeError(t, recorder).ErrorCode != "ISSUE_NOT_CLOSED" {
+		t.Errorf("409 ISSUE_NOT_CLOSED여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+}
+
+func TestReopenIssue_실패_요청_검증(t *testing.T) {
+	router, _ := setupAuthTestServer()
+	admin := map[string]string{httpx.APIKeyHeader: "dev-key"}
+	issue := createIssueAs(t, router, admin)
+	authRequest(router, http.MethodPost, fmt.Sprintf("/issue/%d/transitions/cancel", issue.ID), "", admin)
+	path := fmt.Sprintf("/admin/issue/%d/reopen", issue.ID)
+
+	tests := []struct {
+		body      string
+		errorCode string
+		field     string
+	}{
+		{`{"reason":`, "INVALID_REQUEST", ""},
+		{`{}`, "VALIDATION_FAILED", "reason"},
+		{`{"reason":"` + strings.Repeat("가", 1001) + `"}`, "VALIDATION_FAILED", "reason"},
+	}
+
+	for _, tt := range tests {
+		recorder := authRequest(router, http.MethodPost, path, tt.body, admin)
+		response := decodeError(t, recorder)
+		if recorder.Code != http.StatusBadRequest || response.ErrorCode != tt.errorCode || response.Field != tt.field {
+			t.Errorf("%.40s: 400 %s(%s)여야 함. 실제: %d, %s", tt.body, tt.errorCode, tt.field, recorder.Code, recorder.Body.String())
+		}
+	}
+}
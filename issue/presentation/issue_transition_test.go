@@ -0,0 +1,132 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"issue-service-aoroa/internal/httpx"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func (s *testServer) postTransition(path, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	recorder := httptest.NewRecorder()
+	s.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func transitionNames(t *testing.T, recorder *httptest.ResponseRecorder) []string {
+	var response struct {
+		Transitions []TransitionResponse `json:"transitions"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	names := []string{}
+	for _, transition := range response.Transitions {
+		names = append(names, transition.Name)
+	}
+	return names
+}
+
+func TestGetIssueTransitions_담당자_조건을_반영(t *testing.T) {
+	server := setupTestServer()
+	unassigned := server.createIssue(t, nil)
+	userID := uint(1)
+	assigned := server.createIssue(t, &userID)
+
+	recorder := server.get(fmt.Sprintf("/issue/%d/transitions", unassigned.ID))
+	if recorder.Code != http.StatusOK || recorder.Header().Get("ETag") != `"1"` {
+		t.Fatalf("200과 ETag를 반환해야 함. 실제: %d, %s", recorder.Code, recorder.Header().Get("ETag"))
+	}
+	if names := strings.Join(transitionNames(t, recorder), ","); names != "block,cancel" {
+		t.Errorf("담당자가 없으면 start, complete는 제외되어야 함. 실제: %s", names)
+	}
+
+	recorder = server.get(fmt.Sprintf("/issue/%d/transitions", assigned.ID))
+	if names := strings.Join(transitionNames(t, recorder), ","); names != "stop,request_review,block,complete,cancel" {
+		t.Errorf("진행중 이슈에서 가능한 전이가 다름. 실제: %s", names)
+	}
+}
+
+func TestGetIssueTransitions_요청한_사용자의_역할을_반영(t *testing.T) {
+	router, _ := setupAuthTestServer()
+	admin := map[string]string{httpx.APIKeyHeader: "dev-key"}
+	issue := createIssueAs(t, router, admin)
+	path := fmt.Sprintf("/issue/%d/transitions", issue.ID)
+
+	if names := strings.Join(transitionNames(t, authRequest(router, http.MethodGet, path, "", admin)), ","); names != "block,cancel" {
+		t.Errorf("관리자에게는 취소가 보여야 함. 실제: %s", names)
+	}
+	member := map[string]string{"Authorization": bearerToken(2, time.Now().Add(time.Hour))}
+	if names := strings.Join(transitionNames(t, authRequest(router, http.MethodGet, path, "", member)), ","); names != "block" {
+		t.Errorf("member에게는 취소가 보이지 않아야 함. 실제: %s", names)
+	}
+}
+
+func TestTransitionIssue_성공_코멘트와_함께_실행(t *testing.T) {
+	server := setupTestServer()
+	userID := uint(1)
+	issue := server.createIssue(t, &userID)
+	path := fmt.Sprintf("/issue/%d/transitions/request_review", issue.ID)
+
+	recorder := server.postTransition(path, `{"comment":"리뷰 부탁드립니다"}`)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	var response TransitionResultResponse
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	if response.Issue.Status != "IN_REVIEW" || response.From != "IN_PROGRESS" || response.To != "IN_REVIEW" ||
+		response.Comment != "리뷰 부탁드립니다" || recorder.Header().Get("ETag") != `"2"` {
+		t.Errorf("전이 결과가 다름. 실제: %s, ETag %s", recorder.Body.String(), recorder.Header().Get("ETag"))
+	}
+
+	recorder = server.postTransition(fmt.Sprintf("/issue/%d/transitions/complete", issue.ID), "")
+	if recorder.Code != http.StatusOK {
+		t.Errorf("본문 없이도 실행할 수 있어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+}
+
+func TestTransitionIssue_실패(t *testing.T) {
+	server := setupTestServer()
+	issue := server.createIssue(t, nil)
+	path := func(name string) string { return fmt.Sprintf("/issue/%d/transitions/%s", issue.ID, name) }
+
+	tests := []struct {
+		path      string
+		body      string
+		status    int
+		errorCode string
+	}{
+		{path("start"), "", http.StatusBadRequest, "ASSIGNEE_REQUIRED"},
+		{path("stop"), "", http.StatusBadRequest, "TRANSITION_NOT_ALLOWED"},
+		{path("approve"), "", http.StatusNotFound, "TRANSITION_NOT_FOUND"},
+		{path("block"), `{"comment":"` + strings.Repeat("가", 1001) + `"}`, http.StatusBadRequest, "VALIDATION_FAILED"},
+		{path("block"), `{"comment":`, http.StatusBadRequest, "INVALID_REQUEST"},
+		{"/issue/999/transitions/block", "", http.StatusNotFound, "ISSUE_NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		recorder := server.postTransition(tt.path, tt.body)
+		if recorder.Code != tt.status || decodeError(t, recorder).ErrorCode != tt.errorCode {
+			t.Errorf("%s: %d %s여야 함. 실제: %d, %s", tt.path, tt.status, tt.errorCode, recorder.Code, recorder.Body.String())
+		}
+	}
+}
+
+func TestTransitionIssue_실패_If_Match_불일치(t *testing.T) {
+	server := setupTestServer()
+	issue := server.createIssue(t, nil)
+
+	recorder := server.request(http.MethodPost, fmt.Sprintf("/issue/%d/transitions/block", issue.ID), map[string]string{"If-Match": `"5"`})
+	if recorder.Code != http.StatusPreconditionFailed {
+		t.Errorf("412여야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+}
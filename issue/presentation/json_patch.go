@@ -0,0 +1,178 @@
+package presentation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/issue/application"
+	"issue-service-aoroa/issue/model"
+)
+
+const (
+	reasonUnsupportedOperation = "UNSUPPORTED_OPERATION"
+	reasonInvalidPath          = "INVALID_PATH"
+	reasonMissingValue         = "MISSING_VALUE"
+)
+
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// 패치 경로별로 값을 UpdateCommand 또는 Precondition 필드에 디코딩한다.
+// /version은 읽기 전용이므로 test에만 사용할 수 있다.
+type jsonPatchPath struct {
+	update func(cmd *model.UpdateCommand, raw json.RawMessage) bool
+	test   func(p *model.Precondition, raw json.RawMessage) bool
+}
+
+var jsonPatchPaths = map[string]jsonPatchPath{
+	"/title": {
+		update: func(cmd *model.UpdateCommand, raw json.RawMessage) bool { return decodePatchField(raw, &cmd.Title) },
+		test:   func(p *model.Precondition, raw json.RawMessage) bool { return decodePatchField(raw, &p.Title) },
+	},
+	"/description": {
+		update: func(cmd *model.UpdateCommand, raw json.RawMessage) bool {
+			return decodePatchField(raw, &cmd.Description)
+		},
+		test: func(p *model.Precondition, raw json.RawMessage) bool { return decodePatchField(raw, &p.Description) },
+	},
+	"/status": {
+		update: func(cmd *model.UpdateCommand, raw json.RawMessage) bool { return decodePatchField(raw, &cmd.Status) },
+		test:   func(p *model.Precondition, raw json.RawMessage) bool { return decodePatchField(raw, &p.Status) },
+	},
+	"/userId": {
+		update: func(cmd *model.UpdateCommand, raw json.RawMessage) bool { return decodePatchField(raw, &cmd.UserID) },
+		test:   func(p *model.Precondition, raw json.RawMessage) bool { return decodePatchField(raw, &p.UserID) },
+	},
+	"/user": {
+		update: func(cmd *model.UpdateCommand, raw json.RawMessage) bool { return decodeUserReference(raw, &cmd.UserID) },
+		test:   func(p *model.Precondition, raw json.RawMessage) bool { return decodeUserReference(raw, &p.UserID) },
+	},
+	"/version": {
+		test: func(p *model.Precondition, raw json.RawMessage) bool { return decodePatchField(raw, &p.Version) },
+	},
+}
+
+// RFC 6902 JSON Patch 문서를 순서가 보존된 패치 연산 목록으로 변환한다.
+// add와 replace는 같은 의미이며, remove는 해당 필드를 null로 병합하는 것과 같다.
+func decodeJSONPatch(body []byte) ([]application.PatchOperation, error) {
+	var document []json.RawMessage
+	if err := json.Unmarshal(body, &document); err != nil || document == nil {
+		return nil, httpx.ErrInvalidRequest
+	}
+
+	operations := make([]application.PatchOperation, 0, len(document))
+	var fieldErrors apperr.ValidationErrors
+	for i, raw := range document {
+		operation, err := decodeJSONPatchOperation(fmt.Sprintf("/%d", i), raw)
+		if err != nil {
+			fieldErrors = append(fieldErrors, err)
+			continue
+		}
+		operations = append(operations, operation)
+	}
+
+	if len(fieldErrors) > 0 {
+		return nil, fieldErrors
+	}
+	return operations, nil
+}
+
+func decodeJSONPatchOperation(pointer string, raw json.RawMessage) (application.PatchOperation, *apperr.ValidationError) {
+	var op jsonPatchOperation
+	if err := json.Unmarshal(raw, &op); err != nil {
+		return application.PatchOperation{}, &apperr.ValidationError{
+			Field:   pointer,
+			Reason:  reasonTypeMismatch,
+			Message: pointer + " 연산의 형식이 올바르지 않습니다",
+		}
+	}
+
+	path, ok := jsonPatchPaths[op.Path]
+	if !ok {
+		return application.PatchOperation{}, &apperr.ValidationError{
+			Field:   pointer + "/path",
+			Reason:  reasonInvalidPath,
+			Message: "지원하지 않는 경로입니다: " + op.Path,
+		}
+	}
+
+	hasValue := len(bytes.TrimSpace(op.Value)) > 0
+	switch op.Op {
+	case "add", "replace", "remove":
+		if path.update == nil {
+			return application.PatchOperation{}, &apperr.ValidationError{
+				Field:   pointer + "/path",
+				Reason:  reasonInvalidPath,
+				Message: "수정할 수 없는 경로입니다: " + op.Path,
+			}
+		}
+
+		value := op.Value
+		if op.Op == "remove" {
+			value = json.RawMessage("null")
+		} else if !hasValue {
+			return application.PatchOperation{}, missingValueError(pointer)
+		}
+
+		cmd := model.NewUpdateCommand()
+		if !path.update(cmd, value) {
+			return application.PatchOperation{}, valueTypeMismatchError(pointer)
+		}
+		return application.PatchOperation{Command: cmd}, nil
+	case "test":
+		if !hasValue {
+			return application.PatchOperation{}, missingValueError(pointer)
+		}
+
+		precondition := &model.Precondition{}
+		if !path.test(precondition, op.Value) {
+			return application.PatchOperation{}, valueTypeMismatchError(pointer)
+		}
+		return application.PatchOperation{Test: precondition}, nil
+	default:
+		return application.PatchOperation{}, &apperr.ValidationError{
+			Field:   pointer + "/op",
+			Reason:  reasonUnsupportedOperation,
+			Message: "지원하지 않는 연산입니다: " + op.Op,
+		}
+	}
+}
+
+// /user 경로의 값은 null 또는 {"id": 1} 형태의 사용자 객체이다
+func decodeUserReference(raw json.RawMessage, field *model.PatchField[uint]) bool {
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		*field = model.PatchNull[uint]()
+		return true
+	}
+
+	var user struct {
+		ID *uint `json:"id"`
+	}
+	if err := json.Unmarshal(raw, &user); err != nil || user.ID == nil {
+		return false
+	}
+	*field = model.PatchValue(*user.ID)
+	return true
+}
+
+func missingValueError(pointer string) *apperr.ValidationError {
+	return &apperr.ValidationError{
+		Field:   pointer + "/value",
+		Reason:  reasonMissingValue,
+		Message: pointer + " 연산에 value가 필요합니다",
+	}
+}
+
+func valueTypeMismatchError(pointer string) *apperr.ValidationError {
+	return &apperr.ValidationError{
+		Field:   pointer + "/value",
+		Reason:  reasonTypeMismatch,
+		Message: pointer + "/value 필드의 값 형식이 올바르지 않습니다",
+	}
+}
@@ -0,0 +1,131 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	"issue-service-aoroa/issue/model"
+)
+
+func TestUpdateIssue_JSON_Patch_성공_상태_변경과_담당자_제거(t *testing.T) {
+	server := setupTestServer()
+	userID := uint(1)
+	server.createIssue(t, &userID)
+
+	recorder := server.patch("/issue/1", mimeJSONPatch, `[
+		{"op": "test", "path": "/version", "value": 1},
+		{"op": "remove", "path": "/user"},
+		{"op": "replace", "path": "/title", "value": "새 제목"}
+	]`)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	updated := decodeIssue(t, recorder)
+	if updated.User != nil || updated.Status != model.StatusPending {
+		t.Errorf("담당자가 제거되고 PENDING이어야 함. 실제: %+v", updated)
+	}
+	if updated.Title != "새 제목" {
+		t.Errorf("제목이 변경되어야 함. 실제: %s", updated.Title)
+	}
+}
+
+func TestUpdateIssue_JSON_Patch_성공_담당자_객체로_할당(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", mimeJSONPatch, `[
+		{"op": "replace", "path": "/user", "value": {"id": 2}},
+		{"op": "test", "path": "/status", "value": "IN_PROGRESS"},
+		{"op": "replace", "path": "/status", "value": "COMPLETED"}
+	]`)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	updated := decodeIssue(t, recorder)
+	if updated.User == nil || updated.User.ID != 2 || updated.Status != model.StatusCompleted {
+		t.Errorf("담당자 2로 할당 후 COMPLETED여야 함. 실제: %+v", updated)
+	}
+}
+
+func TestUpdateIssue_JSON_Patch_실패_test_불일치시_전체_미적용(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", mimeJSONPatch, `[
+		{"op": "replace", "path": "/title", "value": "새 제목"},
+		{"op": "test", "path": "/version", "value": 7}
+	]`)
+
+	if recorder.Code != http.StatusPreconditionFailed {
+		t.Fatalf("412여야 함. 실제: %d", recorder.Code)
+	}
+	if response := decodeError(t, recorder); response.ErrorCode != "PATCH_TEST_FAILED" {
+		t.Errorf("PATCH_TEST_FAILED여야 함. 실제: %s", response.ErrorCode)
+	}
+
+	stored, _ := server.service.GetIssueByID(1)
+	if stored.Title != "테스트 이슈" || stored.Version != 1 {
+		t.Errorf("패치가 전혀 적용되지 않아야 함. 실제: %+v", stored)
+	}
+}
+
+func TestUpdateIssue_JSON_Patch_실패_중간_연산_실패시_전체_미적용(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", mimeJSONPatch, `[
+		{"op": "replace", "path": "/title", "value": "새 제목"},
+		{"op": "replace", "path": "/status", "value": "COMPLETED"}
+	]`)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("담당자 없이 완료 처리하면 400이어야 함. 실제: %d", recorder.Code)
+	}
+
+	stored, _ := server.service.GetIssueByID(1)
+	if stored.Title != "테스트 이슈" {
+		t.Errorf("앞선 연산도 적용되지 않아야 함. 실제 제목: %s", stored.Title)
+	}
+}
+
+func TestUpdateIssue_JSON_Patch_실패_지원하지_않는_연산과_경로(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", mimeJSONPatch, `[
+		{"op": "move", "from": "/title", "path": "/description"},
+		{"op": "replace", "path": "/version", "value": 3},
+		{"op": "replace", "path": "/title"}
+	]`)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("400이어야 함. 실제: %d", recorder.Code)
+	}
+	response := decodeError(t, recorder)
+	expected := []struct{ field, reason string }{
+		{"/0/op", reasonUnsupportedOperation},
+		{"/1/path", reasonInvalidPath},
+		{"/2/value", reasonMissingValue},
+	}
+	if len(response.Fields) != len(expected) {
+		t.Fatalf("필드 에러가 %d개여야 함. 실제: %+v", len(expected), response.Fields)
+	}
+	for i, e := range expected {
+		if response.Fields[i].Field != e.field || response.Fields[i].Reason != e.reason {
+			t.Errorf("예상 %s(%s), 실제 %s(%s)", e.field, e.reason, response.Fields[i].Field, response.Fields[i].Reason)
+		}
+	}
+}
+
+func TestUpdateIssue_JSON_Patch_실패_배열이_아닌_문서(t *testing.T) {
+	server := setupTestServer()
+	server.createIssue(t, nil)
+
+	recorder := server.patch("/issue/1", mimeJSONPatch, `{"op": "replace", "path": "/title", "value": "새 제목"}`)
+
+	if response := decodeError(t, recorder); response.ErrorCode != "INVALID_REQUEST" {
+		t.Errorf("INVALID_REQUEST여야 함. 실제: %s", response.ErrorCode)
+	}
+}
@@ -0,0 +1,145 @@
+package presentation
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/issue/application"
+	"issue-service-aoroa/issue/infrastructure"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	unassignedParam = "unassigned"
+	dateLayout      = "2006-01-02"
+)
+
+// 같은 파라미터를 반복하거나(status=A&status=B) 쉼표로 나열(status=A,B)해 여러 값을 지정할 수 있다
+func bindListIssuesQuery(ctx *gin.Context) (application.ListIssuesQuery, error) {
+	query := application.ListIssuesQuery{
+		Filter: infrastructure.IssueFilter{
+			Statuses: multiValueQuery(ctx, "status"),
+			Keyword:  strings.TrimSpace(ctx.Query("keyword")),
+		},
+		Sort:   ctx.Query("sort"),
+		Cursor: ctx.Query("cursor"),
+	}
+
+	var fieldErrors apperr.ValidationErrors
+
+	for _, value := range multiValueQuery(ctx, "userId") {
+		if value == unassignedParam {
+			query.Filter.Unassigned = true
+			continue
+		}
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			fieldErrors = append(fieldErrors, queryTypeMismatch("userId"))
+			break
+		}
+		query.Filter.AssigneeIDs = append(query.Filter.AssigneeIDs, uint(id))
+	}
+
+	for _, value := range multiValueQuery(ctx, "reporterId") {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			fieldErrors = append(fieldErrors, queryTypeMismatch("reporterId"))
+			break
+		}
+		query.Filter.ReporterIDs = append(query.Filter.ReporterIDs, uint(id))
+	}
+
+	for _, param := range []struct {
+		name     string
+		target   **time.Time
+		endOfDay bool
+	}{
+		{"createdFrom", &query.Filter.CreatedFrom, false},
+		{"createdTo", &query.Filter.CreatedTo, true},
+		{"updatedFrom", &query.Filter.UpdatedFrom, false},
+		{"updatedTo", &query.Filter.UpdatedTo, true},
+	} {
+		value := ctx.Query(param.name)
+		if value == "" {
+			continue
+		}
+		t, err := parseQueryTime(value, param.endOfDay)
+		if err != nil {
+			fieldErrors = append(fieldErrors, queryTypeMismatch(param.name))
+			continue
+		}
+		*param.target = &t
+	}
+
+	includeDeleted, fieldErr := boolQuery(ctx, "includeDeleted")
+	if fieldErr != nil {
+		fieldErrors = append(fieldErrors, fieldErr)
+	}
+	query.Filter.IncludeDeleted = includeDeleted
+
+	if limit := ctx.Query("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil {
+			fieldErrors = append(fieldErrors, queryTypeMismatch("limit"))
+		}
+		query.Limit = parsed
+	}
+
+	if len(fieldErrors) > 0 {
+		return query, fieldErrors
+	}
+	return query, nil
+}
+
+func multiValueQuery(ctx *gin.Context, key string) []string {
+	var values []string
+	for _, raw := range ctx.QueryArray(key) {
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// RFC 3339 시각 또는 YYYY-MM-DD 날짜(UTC)를 받는다.
+// 종료 조건에 날짜만 주면 그날 하루 전체가 포함되도록 다음 날 0시로 해석한다.
+func parseQueryTime(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date, nil
+}
+
+// 값이 없으면 false이다
+func boolQuery(ctx *gin.Context, key string) (bool, *apperr.ValidationError) {
+	value := ctx.Query(key)
+	if value == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, queryTypeMismatch(key)
+	}
+	return parsed, nil
+}
+
+func queryTypeMismatch(field string) *apperr.ValidationError {
+	return &apperr.ValidationError{
+		Field:   field,
+		Reason:  reasonTypeMismatch,
+		Message: field + " 필드의 값 형식이 올바르지 않습니다",
+	}
+}
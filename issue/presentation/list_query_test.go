@@ -0,0 +1,138 @@
+package presentation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func (s *testServer) get(path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	s.router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder
+}
+
+func TestGetIssues_성공_쿼리_파라미터_필터(t *testing.T) {
+	server := setupTestServer()
+	userID := uint(1)
+	server.createIssue(t, &userID)
+	server.createIssue(t, nil)
+	server.service.CreateIssue("다른 이슈", "", nil)
+
+	recorder := server.get("/issues?status=PENDING,IN_PROGRESS&userId=unassigned&keyword=테스트&createdFrom=2000-01-01")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var body struct {
+		Issues []struct {
+			ID uint `json:"id"`
+		} `json:"issues"`
+		Total int `json:"total"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &body)
+	if body.Total != 1 || len(body.Issues) != 1 || body.Issues[0].ID != 2 {
+		t.Errorf("미할당 '테스트' 이슈 하나만 조회되어야 함. 실제: %s", recorder.Body.String())
+	}
+}
+
+func TestGetIssues_실패_잘못된_쿼리_파라미터(t *testing.T) {
+	server := setupTestServer()
+
+	recorder := server.get("/issues?userId=abc&reporterId=-1&createdTo=어제&limit=many")
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("400이어야 함. 실제: %d", recorder.Code)
+	}
+	response := decodeError(t, recorder)
+	fields := map[string]bool{}
+	for _, field := range response.Fields {
+		fields[field.Field] = true
+	}
+	for _, expected := range []string{"userId", "reporterId", "createdTo", "limit"} {
+		if !fields[expected] {
+			t.Errorf("%s 필드 에러가 포함되어야 함. 실제: %+v", expected, response.Fields)
+		}
+	}
+}
+
+func TestSearchIssues_성공(t *testing.T) {
+	server := setupTestServer()
+	userID := uint(2)
+	server.createIssue(t, &userID)
+	server.createIssue(t, nil)
+
+	recorder := server.get("/issues/search?q=" + url.QueryEscape("status in (PENDING, IN_PROGRESS) AND assignee = 2 AND created > -7d ORDER BY updatedAt DESC"))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var body struct {
+		Issues []struct {
+			ID uint `json:"id"`
+		} `json:"issues"`
+		Total int `json:"total"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &body)
+	if body.Total != 1 || len(body.Issues) != 1 || body.Issues[0].ID != 1 {
+		t.Errorf("담당자 2의 이슈 하나만 조회되어야 함. 실제: %s", recorder.Body.String())
+	}
+}
+
+func TestSearchIssues_실패_구문_에러_위치(t *testing.T) {
+	server := setupTestServer()
+
+	request := httptest.NewRequest(http.MethodGet, "/issues/search?q="+url.QueryEscape("status = PENDING assignee = 2"), nil)
+	request.Header.Set("Accept-Language", "en")
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("400이어야 함. 실제: %d", recorder.Code)
+	}
+	response := decodeError(t, recorder)
+	if response.ErrorCode != "INVALID_QUERY" || response.Field != "q" {
+		t.Errorf("INVALID_QUERY 에러여야 함. 실제: %+v", response)
+	}
+	if response.Position == nil || *response.Position != 17 {
+		t.Errorf("에러 위치는 17이어야 함. 실제: %s", recorder.Body.String())
+	}
+	if response.Error != "Unexpected 'assignee' at position 17" {
+		t.Errorf("영어 메시지여야 함. 실제: %s", response.Error)
+	}
+}
+
+func TestSearchIssues_성공_전문_검색(t *testing.T) {
+	server := setupTestServer()
+	server.service.CreateIssue("로그인오류", "세션 만료", nil)
+	server.service.CreateIssue("결제 오류", "", nil)
+
+	recorder := server.get("/issues/search?text=" + url.QueryEscape("로그인"))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var body struct {
+		Results []TextSearchResultResponse `json:"results"`
+		Total   int                        `json:"total"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &body)
+	if body.Total != 1 || len(body.Results) != 1 || body.Results[0].Issue.Title != "로그인오류" {
+		t.Fatalf("로그인오류 이슈만 검색되어야 함. 실제: %s", recorder.Body.String())
+	}
+	if body.Results[0].Highlights["title"] != "<em>로그인</em>오류" || body.Results[0].Score <= 0 {
+		t.Errorf("점수와 발췌문이 포함되어야 함. 실제: %+v", body.Results[0])
+	}
+}
+
+func decodeTotal(t *testing.T, recorder *httptest.ResponseRecorder) int {
+	var body struct {
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	return body.Total
+}
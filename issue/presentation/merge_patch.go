@@ -0,0 +1,89 @@
+package presentation
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"issue-service-aoroa/internal/apperr"
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/issue/model"
+)
+
+const (
+	reasonUnknownField = "UNKNOWN_FIELD"
+	reasonTypeMismatch = "TYPE_MISMATCH"
+)
+
+type mergePatchField func(cmd *model.UpdateCommand, raw json.RawMessage) bool
+
+// RFC 7386 JSON Merge Patch 문서에서 수정 가능한 필드.
+// 값이 null이면 필드를 지우는 요청이고, 지울 수 없는 필드인지는 UpdateCommand가 판단한다.
+var mergePatchFields = map[string]mergePatchField{
+	"title": func(cmd *model.UpdateCommand, raw json.RawMessage) bool {
+		return decodePatchField(raw, &cmd.Title)
+	},
+	"description": func(cmd *model.UpdateCommand, raw json.RawMessage) bool {
+		return decodePatchField(raw, &cmd.Description)
+	},
+	"status": func(cmd *model.UpdateCommand, raw json.RawMessage) bool {
+		return decodePatchField(raw, &cmd.Status)
+	},
+	"userId": func(cmd *model.UpdateCommand, raw json.RawMessage) bool {
+		return decodePatchField(raw, &cmd.UserID)
+	},
+}
+
+// 알 수 없는 필드와 타입이 맞지 않는 필드를 모두 모아 한 번에 반환한다
+func decodeMergePatch(body []byte) (*model.UpdateCommand, error) {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(body, &document); err != nil || document == nil {
+		return nil, httpx.ErrInvalidRequest
+	}
+
+	keys := make([]string, 0, len(document))
+	for key := range document {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	cmd := model.NewUpdateCommand()
+	var fieldErrors apperr.ValidationErrors
+	for _, key := range keys {
+		decode, ok := mergePatchFields[key]
+		if !ok {
+			fieldErrors = append(fieldErrors, &apperr.ValidationError{
+				Field:   key,
+				Reason:  reasonUnknownField,
+				Message: "알 수 없는 필드입니다: " + key,
+			})
+			continue
+		}
+		if !decode(cmd, document[key]) {
+			fieldErrors = append(fieldErrors, &apperr.ValidationError{
+				Field:   key,
+				Reason:  reasonTypeMismatch,
+				Message: key + " 필드의 값 형식이 올바르지 않습니다",
+			})
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return nil, fieldErrors
+	}
+	return cmd, nil
+}
+
+func decodePatchField[T any](raw json.RawMessage, field *model.PatchField[T]) bool {
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		*field = model.PatchNull[T]()
+		return true
+	}
+
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return false
+	}
+	*field = model.PatchValue(value)
+	return true
+}
@@ -0,0 +1,192 @@
+package presentation
+
+import "issue-service-aoroa/i18n"
+
+// 검증 에러 메시지의 {field}는 실패한 필드 이름으로,
+// 검색 쿼리 에러 메시지의 {position}과 {token}은 에러 위치와 문제가 된 토큰으로 치환된다
+var errorMessages = i18n.Catalog{
+	"VALIDATION_FAILED.title": {
+		i18n.Korean:  "제목은 필수입니다",
+		i18n.English: "Title is required",
+	},
+	"VALIDATION_FAILED.NULL_STATUS": {
+		i18n.Korean:  "상태는 null일 수 없습니다",
+		i18n.English: "Status cannot be null",
+	},
+	"VALIDATION_FAILED.limit": {
+		i18n.Korean:  "limit은 1 이상 100 이하여야 합니다",
+		i18n.English: "limit must be between 1 and 100",
+	},
+	"VALIDATION_FAILED.sort": {
+		i18n.Korean:  "지원하지 않는 정렬 기준입니다",
+		i18n.English: "Unsupported sort order",
+	},
+	"VALIDATION_FAILED.INVALID_CURSOR": {
+		i18n.Korean:  "유효하지 않은 커서입니다",
+		i18n.English: "The cursor is invalid",
+	},
+	"VALIDATION_FAILED.createdTo": {
+		i18n.Korean:  "종료 시각은 시작 시각보다 뒤여야 합니다",
+		i18n.English: "The end time must be after the start time",
+	},
+	"VALIDATION_FAILED.updatedTo": {
+		i18n.Korean:  "종료 시각은 시작 시각보다 뒤여야 합니다",
+		i18n.English: "The end time must be after the start time",
+	},
+	"VALIDATION_FAILED.text": {
+		i18n.Korean:  "검색어는 필수입니다",
+		i18n.English: "Search text is required",
+	},
+	"VALIDATION_FAILED.ORDER_BY_WITH_TEXT_SEARCH": {
+		i18n.Korean:  "전문 검색 결과는 관련도순으로 정렬되므로 ORDER BY를 쓸 수 없습니다",
+		i18n.English: "ORDER BY cannot be used with text search because results are ranked by relevance",
+	},
+	"VALIDATION_FAILED.asOf": {
+		i18n.Korean:  "asOf는 RFC 3339 형식의 시각이어야 합니다",
+		i18n.English: "asOf must be an RFC 3339 timestamp",
+	},
+	"VALIDATION_FAILED.comment": {
+		i18n.Korean:  "코멘트는 1000자 이하여야 합니다",
+		i18n.English: "Comment must be at most 1000 characters",
+	},
+	"VALIDATION_FAILED.reason": {
+		i18n.Korean:  "다시 여는 사유는 필수입니다",
+		i18n.English: "A reason is required to reopen an issue",
+	},
+	"VALIDATION_FAILED.SELF_SUCCESSOR": {
+		i18n.Korean:  "자기 자신에게는 이슈를 넘길 수 없습니다",
+		i18n.English: "Issues cannot be handed off to the same user",
+	},
+	"VALIDATION_FAILED.TOO_LONG": {
+		i18n.Korean:  "{field}: 1000자 이하여야 합니다",
+		i18n.English: "{field}: must be at most 1000 characters",
+	},
+	"VALIDATION_FAILED.UNKNOWN_FIELD": {
+		i18n.Korean:  "알 수 없는 필드입니다: {field}",
+		i18n.English: "Unknown field: {field}",
+	},
+	"VALIDATION_FAILED.TYPE_MISMATCH": {
+		i18n.Korean:  "{field} 필드의 값 형식이 올바르지 않습니다",
+		i18n.English: "Field {field} has an invalid type",
+	},
+	"VALIDATION_FAILED.UNSUPPORTED_OPERATION": {
+		i18n.Korean:  "{field}: 지원하지 않는 패치 연산입니다",
+		i18n.English: "{field}: unsupported patch operation",
+	},
+	"VALIDATION_FAILED.INVALID_PATH": {
+		i18n.Korean:  "{field}: 지원하지 않거나 수정할 수 없는 경로입니다",
+		i18n.English: "{field}: the path is not supported or is read-only",
+	},
+	"VALIDATION_FAILED.MISSING_VALUE": {
+		i18n.Korean:  "{field}: 값이 필요합니다",
+		i18n.English: "{field}: a value is required",
+	},
+	"INVALID_QUERY": {
+		i18n.Korean:  "검색 쿼리가 올바르지 않습니다",
+		i18n.English: "The search query is invalid",
+	},
+	"INVALID_QUERY.UNEXPECTED_TOKEN": {
+		i18n.Korean:  "위치 {position}: 예상하지 못한 '{token}'입니다",
+		i18n.English: "Unexpected '{token}' at position {position}",
+	},
+	"INVALID_QUERY.UNEXPECTED_END": {
+		i18n.Korean:  "위치 {position}: 쿼리가 완성되지 않았습니다",
+		i18n.English: "Unexpected end of query at position {position}",
+	},
+	"INVALID_QUERY.UNTERMINATED_STRING": {
+		i18n.Korean:  "위치 {position}: 닫히지 않은 문자열입니다",
+		i18n.English: "Unterminated string at position {position}",
+	},
+	"INVALID_QUERY.UNKNOWN_FIELD": {
+		i18n.Korean:  "위치 {position}: 알 수 없거나 사용할 수 없는 필드입니다: {token}",
+		i18n.English: "Unknown or unusable field at position {position}: {token}",
+	},
+	"INVALID_QUERY.UNSUPPORTED_OPERATOR": {
+		i18n.Korean:  "위치 {position}: 이 필드에는 {token} 연산자를 사용할 수 없습니다",
+		i18n.English: "Operator {token} cannot be used with this field at position {position}",
+	},
+	"INVALID_QUERY.INVALID_VALUE": {
+		i18n.Korean:  "위치 {position}: 값이 올바르지 않습니다: {token}",
+		i18n.English: "Invalid value at position {position}: {token}",
+	},
+	"UNSUPPORTED_MEDIA_TYPE": {
+		i18n.Korean:  "지원하지 않는 Content-Type입니다",
+		i18n.English: "Unsupported Content-Type",
+	},
+	"USER_NOT_FOUND": {
+		i18n.Korean:  "사용자를 찾을 수 없습니다",
+		i18n.English: "User not found",
+	},
+	"INVALID_STATUS": {
+		i18n.Korean:  "유효하지 않은 상태입니다",
+		i18n.English: "The status is invalid",
+	},
+	"ISSUE_LOCKED": {
+		i18n.Korean:  "완료되거나 취소된 이슈는 수정할 수 없습니다",
+		i18n.English: "Completed or cancelled issues cannot be modified",
+	},
+	"ASSIGNEE_REQUIRED": {
+		i18n.Korean:  "담당자 없이는 진행중 또는 완료 상태로 변경할 수 없습니다",
+		i18n.English: "An assignee is required to move an issue to in progress or completed",
+	},
+	"ASSIGNEE_INACTIVE": {
+		i18n.Korean:  "비활성화된 사용자에게는 이슈를 할당할 수 없습니다",
+		i18n.English: "Issues cannot be assigned to a deactivated user",
+	},
+	"TRANSITION_NOT_ALLOWED": {
+		i18n.Korean:  "현재 상태에서 요청한 상태로 변경할 수 없습니다",
+		i18n.English: "The issue cannot move from its current status to the requested status",
+	},
+	"TRANSITION_NOT_FOUND": {
+		i18n.Korean:  "워크플로에 정의되지 않은 전이입니다",
+		i18n.English: "The transition is not defined in the workflow",
+	},
+	"ISSUE_NOT_CLOSED": {
+		i18n.Korean:  "종료되지 않은 이슈는 다시 열 수 없습니다",
+		i18n.English: "Only completed or cancelled issues can be reopened",
+	},
+	"TIME_TRAVEL_UNSUPPORTED": {
+		i18n.Korean:  "현재 저장소에서는 과거 시점 조회를 지원하지 않습니다",
+		i18n.English: "Point-in-time lookups are not supported by the current storage",
+	},
+	"ISSUE_NOT_FOUND": {
+		i18n.Korean:  "이슈를 찾을 수 없습니다",
+		i18n.English: "Issue not found",
+	},
+	"ISSUE_DELETED": {
+		i18n.Korean:  "삭제된 이슈입니다. 복원한 뒤 수정하세요",
+		i18n.English: "The issue has been deleted. Restore it before modifying",
+	},
+	"ISSUE_NOT_DELETED": {
+		i18n.Korean:  "삭제되지 않은 이슈입니다",
+		i18n.English: "The issue is not deleted",
+	},
+	"PERMISSION_DENIED.READ_ONLY_ROLE": {
+		i18n.Korean:  "조회 권한만 있는 사용자는 이슈를 변경할 수 없습니다",
+		i18n.English: "Viewers cannot modify issues",
+	},
+	"PERMISSION_DENIED.REPORTER_CREATE_ONLY": {
+		i18n.Korean:  "보고자는 이슈를 등록만 할 수 있습니다",
+		i18n.English: "Reporters can only create issues",
+	},
+	"PERMISSION_DENIED.TRANSITION_REQUIRES_ASSIGNEE": {
+		i18n.Korean:  "담당자나 관리자만 실행할 수 있는 상태 변경입니다",
+		i18n.English: "Only the assignee or an administrator can make this status change",
+	},
+	"PERMISSION_DENIED.TRANSITION_REQUIRES_ADMIN": {
+		i18n.Korean:  "관리자만 실행할 수 있는 상태 변경입니다",
+		i18n.English: "Only an administrator can make this status change",
+	},
+	"VERSION_CONFLICT": {
+		i18n.Korean:  "이슈가 이미 다른 요청에 의해 수정되었습니다",
+		i18n.English: "The issue has already been modified by another request",
+	},
+	"PRECONDITION_FAILED": {
+		i18n.Korean:  "If-Match 헤더가 이슈의 현재 버전과 일치하지 않습니다",
+		i18n.English: "The If-Match header does not match the current version of the issue",
+	},
+	"PATCH_TEST_FAILED": {
+		i18n.Korean:  "이슈의 현재 값이 패치의 test 조건과 일치하지 않습니다",
+		i18n.English: "The issue does not match the test operation in the patch",
+	},
+}
@@ -0,0 +1,250 @@
+package query
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"issue-service-aoroa/issue/model"
+)
+
+type Field string
+
+const (
+	FieldID          Field = "id"
+	FieldStatus      Field = "status"
+	FieldAssignee    Field = "assignee"
+	FieldTitle       Field = "title"
+	FieldDescription Field = "description"
+	FieldCreated     Field = "created"
+	FieldUpdated     Field = "updated"
+	FieldReopens     Field = "reopens"
+)
+
+// 목록 조회 API의 파라미터·정렬 이름도 그대로 쓸 수 있도록 별칭을 둔다
+var fieldAliases = map[string]Field{
+	"id":          FieldID,
+	"status":      FieldStatus,
+	"assignee":    FieldAssignee,
+	"userid":      FieldAssignee,
+	"title":       FieldTitle,
+	"description": FieldDescription,
+	"created":     FieldCreated,
+	"createdat":   FieldCreated,
+	"updated":     FieldUpdated,
+	"updatedat":   FieldUpdated,
+	"reopens":     FieldReopens,
+	"reopencount": FieldReopens,
+}
+
+type Operator string
+
+const (
+	OpEqual          Operator = "="
+	OpNotEqual       Operator = "!="
+	OpGreater        Operator = ">"
+	OpGreaterOrEqual Operator = ">="
+	OpLess           Operator = "<"
+	OpLessOrEqual    Operator = "<="
+	OpContains       Operator = "~"
+	OpNotContains    Operator = "!~"
+	OpIn             Operator = "IN"
+	OpNotIn          Operator = "NOT IN"
+	OpIsEmpty        Operator = "IS EMPTY"
+	OpIsNotEmpty     Operator = "IS NOT EMPTY"
+)
+
+type valueKind int
+
+const (
+	kindNumber valueKind = iota
+	kindStatus
+	kindText
+	kindTime
+)
+
+type fieldSpec struct {
+	kind      valueKind
+	operators []Operator
+	sortable  bool
+}
+
+var fieldSpecs = map[Field]fieldSpec{
+	FieldID: {
+		kind:      kindNumber,
+		operators: []Operator{OpEqual, OpNotEqual, OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual, OpIn, OpNotIn},
+		sortable:  true,
+	},
+	FieldStatus: {
+		kind:      kindStatus,
+		operators: []Operator{OpEqual, OpNotEqual, OpIn, OpNotIn},
+	},
+	FieldAssignee: {
+		kind:      kindNumber,
+		operators: []Operator{OpEqual, OpNotEqual, OpIn, OpNotIn, OpIsEmpty, OpIsNotEmpty},
+	},
+	FieldTitle: {
+		kind:      kindText,
+		operators: []Operator{OpEqual, OpNotEqual, OpContains, OpNotContains},
+		sortable:  true,
+	},
+	FieldDescription: {
+		kind:      kindText,
+		operators: []Operator{OpEqual, OpNotEqual, OpContains, OpNotContains, OpIsEmpty, OpIsNotEmpty},
+	},
+	FieldCreated: {
+		kind:      kindTime,
+		operators: []Operator{OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual},
+		sortable:  true,
+	},
+	FieldUpdated: {
+		kind:      kindTime,
+		operators: []Operator{OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual},
+		sortable:  true,
+	},
+	FieldReopens: {
+		kind:      kindNumber,
+		operators: []Operator{OpEqual, OpNotEqual, OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual, OpIn, OpNotIn},
+	},
+}
+
+// 필드 종류에 따라 Number, Text, Time 중 하나만 채워진다
+type Value struct {
+	Number uint
+	Text   string
+	Time   time.Time
+}
+
+type Expr interface {
+	Matches(issue model.Issue) bool
+}
+
+type And struct {
+	Left, Right Expr
+}
+
+type Or struct {
+	Left, Right Expr
+}
+
+type Not struct {
+	Expr Expr
+}
+
+// IN과 NOT IN은 Values에 여러 값을, IS EMPTY와 IS NOT EMPTY는 값을 갖지 않는다
+type Comparison struct {
+	Field    Field
+	Operator Operator
+	Values   []Value
+}
+
+func (e *And) Matches(issue model.Issue) bool {
+	return e.Left.Matches(issue) && e.Right.Matches(issue)
+}
+
+func (e *Or) Matches(issue model.Issue) bool {
+	return e.Left.Matches(issue) || e.Right.Matches(issue)
+}
+
+func (e *Not) Matches(issue model.Issue) bool {
+	return !e.Expr.Matches(issue)
+}
+
+// 담당자가 없는 이슈는 어떤 담당자 ID와도 같지 않으므로 assignee != 2 조건을 만족한다
+func (e *Comparison) Matches(issue model.Issue) bool {
+	switch e.Operator {
+	case OpIsEmpty:
+		return e.isEmpty(issue)
+	case OpIsNotEmpty:
+		return !e.isEmpty(issue)
+	case OpEqual, OpIn:
+		return e.equalsAny(issue)
+	case OpNotEqual, OpNotIn:
+		return !e.equalsAny(issue)
+	case OpContains:
+		return e.contains(issue)
+	case OpNotContains:
+		return !e.contains(issue)
+	}
+
+	result := e.compare(issue, e.Values[0])
+	switch e.Operator {
+	case OpGreater:
+		return result > 0
+	case OpGreaterOrEqual:
+		return result >= 0
+	case OpLess:
+		return result < 0
+	case OpLessOrEqual:
+		return result <= 0
+	default:
+		return false
+	}
+}
+
+func (e *Comparison) isEmpty(issue model.Issue) bool {
+	switch e.Field {
+	case FieldAssignee:
+		return issue.User == nil
+	case FieldDescription:
+		return issue.Description == ""
+	default:
+		return false
+	}
+}
+
+func (e *Comparison) equalsAny(issue model.Issue) bool {
+	return slices.ContainsFunc(e.Values, func(value Value) bool {
+		switch e.Field {
+		case FieldAssignee:
+			return issue.User != nil && issue.User.ID == value.Number
+		case FieldID, FieldReopens:
+			return numberOf(issue, e.Field) == value.Number
+		default:
+			return textOf(issue, e.Field) == value.Text
+		}
+	})
+}
+
+// ~ 연산자는 대소문자를 구분하지 않는 부분 일치이다
+func (e *Comparison) contains(issue model.Issue) bool {
+	return strings.Contains(strings.ToLower(textOf(issue, e.Field)), strings.ToLower(e.Values[0].Text))
+}
+
+func (e *Comparison) compare(issue model.Issue, value Value) int {
+	switch e.Field {
+	case FieldID, FieldReopens:
+		return cmp.Compare(numberOf(issue, e.Field), value.Number)
+	case FieldCreated:
+		return issue.CreatedAt.Compare(value.Time)
+	case FieldUpdated:
+		return issue.UpdatedAt.Compare(value.Time)
+	default:
+		return 0
+	}
+}
+
+func numberOf(issue model.Issue, field Field) uint {
+	switch field {
+	case FieldID:
+		return issue.ID
+	case FieldReopens:
+		return issue.ReopenCount
+	default:
+		return 0
+	}
+}
+
+func textOf(issue model.Issue, field Field) string {
+	switch field {
+	case FieldStatus:
+		return issue.Status
+	case FieldTitle:
+		return issue.Title
+	case FieldDescription:
+		return issue.Description
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,30 @@
+package query
+
+import "fmt"
+
+const CodeInvalidQuery = "INVALID_QUERY"
+
+const (
+	ReasonUnexpectedToken     = "UNEXPECTED_TOKEN"
+	ReasonUnexpectedEnd       = "UNEXPECTED_END"
+	ReasonUnterminatedString  = "UNTERMINATED_STRING"
+	ReasonUnknownField        = "UNKNOWN_FIELD"
+	ReasonUnsupportedOperator = "UNSUPPORTED_OPERATOR"
+	ReasonInvalidValue        = "INVALID_VALUE"
+)
+
+// Position은 쿼리 문자열에서 문제가 된 토큰의 시작 위치이다 (0부터 시작하는 문자 단위)
+type SyntaxError struct {
+	Position int
+	Reason   string
+	Token    string
+	Message  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("위치 %d: %s", e.Position, e.Message)
+}
+
+func (e *SyntaxError) ErrorCode() string {
+	return CodeInvalidQuery
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// 키워드는 따옴표로 감싸지 않은 단어일 때만 대소문자 구분 없이 인식한다
+func (t token) is(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "쿼리의 끝"
+	}
+	return t.text
+}
+
+const operatorChars = "=!<>~"
+
+// 공백, 괄호, 쉼표, 연산자, 따옴표가 아닌 문자가 이어지면 하나의 단어로 본다.
+// 그래서 2025-04-01, -7d 같은 값도 단어 토큰이 되고 의미는 파서가 필드 종류에 맞게 해석한다.
+func tokenize(input string) ([]token, error) {
+	runes := []rune(input)
+	var tokens []token
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: i})
+			i++
+		case r == '"' || r == '\'':
+			text, next, err := readString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i})
+			i = next
+		case strings.ContainsRune(operatorChars, r):
+			start := i
+			for i < len(runes) && strings.ContainsRune(operatorChars, runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: string(runes[start:i]), pos: start})
+		default:
+			start := i
+			for i < len(runes) && isWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[start:i]), pos: start})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+func isWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && !strings.ContainsRune("(),\"'"+operatorChars, r)
+}
+
+// 문자열 안에서는 백슬래시로 따옴표와 백슬래시 자신을 이스케이프할 수 있다
+func readString(runes []rune, start int) (string, int, error) {
+	quote := runes[start]
+	var text strings.Builder
+
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				text.WriteRune(runes[i])
+			}
+		case quote:
+			return text.String(), i + 1, nil
+		default:
+			text.WriteRune(runes[i])
+		}
+	}
+
+	return "", 0, &SyntaxError{
+		Position: start,
+		Reason:   ReasonUnterminatedString,
+		Token:    string(quote),
+		Message:  "닫히지 않은 문자열입니다",
+	}
+}
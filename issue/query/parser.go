@@ -0,0 +1,374 @@
+package query
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"issue-service-aoroa/issue/model"
+)
+
+// Where가 nil이면 모든 이슈가 조건을 만족하고, OrderBy가 nil이면 정렬 기준을 지정하지 않은 것이다
+type Query struct {
+	Where   Expr
+	OrderBy *OrderBy
+}
+
+type OrderBy struct {
+	Field      Field
+	Descending bool
+}
+
+func (q *Query) Matches(issue model.Issue) bool {
+	return q.Where == nil || q.Where.Matches(issue)
+}
+
+var reservedWords = []string{"AND", "OR", "NOT", "IN", "IS", "EMPTY", "NULL", "ORDER", "BY"}
+
+// 예: status in (PENDING, IN_PROGRESS) AND assignee = 2 AND created > -7d ORDER BY updated DESC
+//
+// 상대 시각(-7d, -12h)은 now를 기준으로 절대 시각으로 바꿔 둔다.
+func Parse(input string, now time.Time) (*Query, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, now: now}
+	query := &Query{}
+
+	if !p.peek().is("ORDER") && p.peek().kind != tokenEOF {
+		if query.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().is("ORDER") {
+		if query.OrderBy, err = p.parseOrderBy(); err != nil {
+			return nil, err
+		}
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		expected := "AND, OR 또는 ORDER BY가 필요합니다"
+		if query.OrderBy != nil {
+			expected = "ORDER BY 뒤에는 하나의 정렬 기준만 올 수 있습니다"
+		}
+		return nil, p.unexpected(tok, expected)
+	}
+	return query, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	now    time.Time
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is("AND") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.peek().is("NOT") {
+		p.next()
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	if p.peek().kind != tokenLParen {
+		return p.parseComparison()
+	}
+
+	p.next()
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.next(); tok.kind != tokenRParen {
+		return nil, p.unexpected(tok, "닫는 괄호가 필요합니다")
+	}
+	return expr, nil
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	field, spec, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+
+	opToken := p.peek()
+	operator, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(spec.operators, operator) {
+		return nil, &SyntaxError{
+			Position: opToken.pos,
+			Reason:   ReasonUnsupportedOperator,
+			Token:    string(operator),
+			Message:  string(operator) + " 연산자는 " + string(field) + " 필드에 사용할 수 없습니다",
+		}
+	}
+
+	comparison := &Comparison{Field: field, Operator: operator}
+	switch operator {
+	case OpIsEmpty, OpIsNotEmpty:
+	case OpIn, OpNotIn:
+		comparison.Values, err = p.parseValueList(spec.kind)
+	default:
+		var value Value
+		value, err = p.parseValue(spec.kind)
+		comparison.Values = []Value{value}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return comparison, nil
+}
+
+func (p *parser) parseField() (Field, fieldSpec, error) {
+	tok := p.next()
+	if tok.kind != tokenWord || isReserved(tok) {
+		return "", fieldSpec{}, p.unexpected(tok, "필드 이름이 필요합니다")
+	}
+
+	field, ok := fieldAliases[strings.ToLower(tok.text)]
+	if !ok {
+		return "", fieldSpec{}, &SyntaxError{
+			Position: tok.pos,
+			Reason:   ReasonUnknownField,
+			Token:    tok.text,
+			Message:  "알 수 없는 필드입니다: " + tok.text,
+		}
+	}
+	return field, fieldSpecs[field], nil
+}
+
+func (p *parser) parseOperator() (Operator, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == tokenOperator:
+		operator := Operator(tok.text)
+		switch operator {
+		case OpEqual, OpNotEqual, OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual, OpContains, OpNotContains:
+			return operator, nil
+		}
+	case tok.is("IN"):
+		return OpIn, nil
+	case tok.is("NOT"):
+		if in := p.next(); !in.is("IN") {
+			return "", p.unexpected(in, "NOT 뒤에는 IN이 필요합니다")
+		}
+		return OpNotIn, nil
+	case tok.is("IS"):
+		negated := false
+		if p.peek().is("NOT") {
+			p.next()
+			negated = true
+		}
+		if empty := p.next(); !empty.is("EMPTY") && !empty.is("NULL") {
+			return "", p.unexpected(empty, "IS 뒤에는 EMPTY가 필요합니다")
+		}
+		if negated {
+			return OpIsNotEmpty, nil
+		}
+		return OpIsEmpty, nil
+	}
+	return "", p.unexpected(tok, "연산자가 필요합니다")
+}
+
+func (p *parser) parseValueList(kind valueKind) ([]Value, error) {
+	if tok := p.next(); tok.kind != tokenLParen {
+		return nil, p.unexpected(tok, "IN 뒤에는 여는 괄호가 필요합니다")
+	}
+
+	var values []Value
+	for {
+		value, err := p.parseValue(kind)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		tok := p.next()
+		if tok.kind == tokenRParen {
+			return values, nil
+		}
+		if tok.kind != tokenComma {
+			return nil, p.unexpected(tok, "쉼표 또는 닫는 괄호가 필요합니다")
+		}
+	}
+}
+
+func (p *parser) parseValue(kind valueKind) (Value, error) {
+	tok := p.next()
+	if (tok.kind != tokenWord && tok.kind != tokenString) || (tok.kind == tokenWord && isReserved(tok)) {
+		return Value{}, p.unexpected(tok, "값이 필요합니다")
+	}
+
+	invalid := func(message string) (Value, error) {
+		return Value{}, &SyntaxError{
+			Position: tok.pos,
+			Reason:   ReasonInvalidValue,
+			Token:    tok.text,
+			Message:  message + ": " + tok.text,
+		}
+	}
+
+	switch kind {
+	case kindNumber:
+		number, err := strconv.ParseUint(tok.text, 10, 32)
+		if err != nil {
+			return invalid("숫자가 필요합니다")
+		}
+		return Value{Number: uint(number)}, nil
+	case kindStatus:
+		status := strings.ToUpper(tok.text)
+		if !model.IsValidStatus(status) {
+			return invalid("유효하지 않은 상태입니다")
+		}
+		return Value{Text: status}, nil
+	case kindTime:
+		t, ok := parseTime(tok.text, p.now)
+		if !ok {
+			return invalid("시각은 -7d 같은 상대 시각, YYYY-MM-DD 또는 RFC 3339 형식이어야 합니다")
+		}
+		return Value{Time: t}, nil
+	default:
+		return Value{Text: tok.text}, nil
+	}
+}
+
+func (p *parser) parseOrderBy() (*OrderBy, error) {
+	p.next()
+	if tok := p.next(); !tok.is("BY") {
+		return nil, p.unexpected(tok, "ORDER 뒤에는 BY가 필요합니다")
+	}
+
+	tok := p.peek()
+	field, spec, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+	if !spec.sortable {
+		return nil, &SyntaxError{
+			Position: tok.pos,
+			Reason:   ReasonUnknownField,
+			Token:    tok.text,
+			Message:  "정렬할 수 없는 필드입니다: " + tok.text,
+		}
+	}
+
+	orderBy := &OrderBy{Field: field}
+	switch {
+	case p.peek().is("ASC"):
+		p.next()
+	case p.peek().is("DESC"):
+		p.next()
+		orderBy.Descending = true
+	}
+	return orderBy, nil
+}
+
+func (p *parser) unexpected(tok token, expected string) *SyntaxError {
+	if tok.kind == tokenEOF {
+		return &SyntaxError{
+			Position: tok.pos,
+			Reason:   ReasonUnexpectedEnd,
+			Message:  "쿼리가 끝났습니다. " + expected,
+		}
+	}
+	return &SyntaxError{
+		Position: tok.pos,
+		Reason:   ReasonUnexpectedToken,
+		Token:    tok.text,
+		Message:  "예상하지 못한 '" + tok.text + "'입니다. " + expected,
+	}
+}
+
+func isReserved(tok token) bool {
+	return slices.ContainsFunc(reservedWords, tok.is)
+}
+
+var relativeTimePattern = regexp.MustCompile(`^([+-])(\d+)([mhdw])$`)
+
+// 상대 시각의 단위는 m(분), h(시간), d(일), w(주)이다. 날짜만 주면 UTC 0시로 해석한다.
+func parseTime(text string, now time.Time) (time.Time, bool) {
+	if match := relativeTimePattern.FindStringSubmatch(text); match != nil {
+		amount, err := strconv.Atoi(match[2])
+		if err != nil {
+			return time.Time{}, false
+		}
+		if match[1] == "-" {
+			amount = -amount
+		}
+
+		switch match[3] {
+		case "m":
+			return now.Add(time.Duration(amount) * time.Minute), true
+		case "h":
+			return now.Add(time.Duration(amount) * time.Hour), true
+		case "d":
+			return now.AddDate(0, 0, amount), true
+		default:
+			return now.AddDate(0, 0, amount*7), true
+		}
+	}
+
+	if t, err := time.Parse(time.RFC3339, text); err == nil {
+		return t, true
+	}
+	if t, err := time.Parse("2006-01-02", text); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
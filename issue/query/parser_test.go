@@ -0,0 +1,147 @@
+package query
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"issue-service-aoroa/issue/model"
+	userModel "issue-service-aoroa/user/model"
+)
+
+var testNow = time.Date(2025, 4, 15, 12, 0, 0, 0, time.UTC)
+
+func testIssue(id uint, status string, assignee *uint, createdAt time.Time) model.Issue {
+	issue := model.Issue{
+		ID:          id,
+		Title:       "로그인 버그",
+		Description: "세션이 만료됩니다",
+		Status:      status,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+	if assignee != nil {
+		issue.User = &userModel.User{ID: *assignee}
+	}
+	return issue
+}
+
+func TestParse_성공_조건_평가(t *testing.T) {
+	two := uint(2)
+	assigned := testIssue(1, model.StatusInProgress, &two, testNow.AddDate(0, 0, -3))
+	unassigned := testIssue(2, model.StatusPending, nil, testNow.AddDate(0, 0, -10))
+	unassigned.ReopenCount = 2
+
+	tests := []struct {
+		query      string
+		assigned   bool
+		unassigned bool
+	}{
+		{"status in (PENDING, IN_PROGRESS) AND assignee = 2 AND created > -7d", true, false},
+		{"status = pending", false, true},
+		{"status not in (COMPLETED, CANCELLED)", true, true},
+		{"assignee != 2", false, true},
+		{"assignee is empty", false, true},
+		{"assignee IS NOT EMPTY", true, false},
+		{"assignee in (1, 2)", true, false},
+		{"NOT assignee = 2", false, true},
+		{"created >= 2025-04-10 OR id = 2", true, true},
+		{"created < \"2025-04-10T00:00:00+09:00\"", false, true},
+		{"title ~ 로그인 AND description ~ '만료'", true, true},
+		{"title !~ LOGIN", true, true},
+		{"title = \"로그인 버그\" AND (id > 1 OR status = IN_PROGRESS)", true, true},
+		{"status = PENDING OR status = IN_PROGRESS AND assignee is empty", false, true},
+		{"description is empty", false, false},
+		{"reopens > 0", false, true},
+		{"reopenCount in (0, 1)", true, false},
+		{"", true, true},
+		{"ORDER BY id", true, true},
+	}
+
+	for _, tt := range tests {
+		q, err := Parse(tt.query, testNow)
+		if err != nil {
+			t.Errorf("%q: 에러가 발생하지 않아야 함: %v", tt.query, err)
+			continue
+		}
+		if got := q.Matches(assigned); got != tt.assigned {
+			t.Errorf("%q: 할당된 이슈 예상 %v, 실제 %v", tt.query, tt.assigned, got)
+		}
+		if got := q.Matches(unassigned); got != tt.unassigned {
+			t.Errorf("%q: 미할당 이슈 예상 %v, 실제 %v", tt.query, tt.unassigned, got)
+		}
+	}
+}
+
+func TestParse_성공_정렬(t *testing.T) {
+	q, err := Parse("assignee = 2 ORDER BY updatedAt DESC", testNow)
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if q.OrderBy == nil || q.OrderBy.Field != FieldUpdated || !q.OrderBy.Descending {
+		t.Errorf("updated 내림차순이어야 함. 실제: %+v", q.OrderBy)
+	}
+
+	q, _ = Parse("status = PENDING", testNow)
+	if q.OrderBy != nil {
+		t.Errorf("정렬 기준이 없어야 함. 실제: %+v", q.OrderBy)
+	}
+}
+
+func TestParse_성공_상대_시각(t *testing.T) {
+	tests := map[string]time.Time{
+		"-7d":  testNow.AddDate(0, 0, -7),
+		"-2w":  testNow.AddDate(0, 0, -14),
+		"-12h": testNow.Add(-12 * time.Hour),
+		"+30m": testNow.Add(30 * time.Minute),
+	}
+
+	for text, expected := range tests {
+		q, err := Parse("created > "+text, testNow)
+		if err != nil {
+			t.Fatalf("%s: 에러가 발생하지 않아야 함: %v", text, err)
+		}
+		if got := q.Where.(*Comparison).Values[0].Time; !got.Equal(expected) {
+			t.Errorf("%s: 예상 %v, 실제 %v", text, expected, got)
+		}
+	}
+}
+
+func TestParse_실패_에러_위치(t *testing.T) {
+	tests := []struct {
+		query    string
+		position int
+		reason   string
+	}{
+		{"status = PENDING AND", 20, ReasonUnexpectedEnd},
+		{"status = PENDING assignee = 2", 17, ReasonUnexpectedToken},
+		{"priority = HIGH", 0, ReasonUnknownField},
+		{"status > PENDING", 7, ReasonUnsupportedOperator},
+		{"created = -7d", 8, ReasonUnsupportedOperator},
+		{"status = DONE", 9, ReasonInvalidValue},
+		{"assignee = kim", 11, ReasonInvalidValue},
+		{"created > yesterday", 10, ReasonInvalidValue},
+		{"title = \"로그인", 8, ReasonUnterminatedString},
+		{"(status = PENDING", 17, ReasonUnexpectedEnd},
+		{"status in (PENDING IN_PROGRESS)", 19, ReasonUnexpectedToken},
+		{"상태 = PENDING", 0, ReasonUnknownField},
+		{"status == PENDING", 7, ReasonUnexpectedToken},
+		{"ORDER BY status", 9, ReasonUnknownField},
+		{"ORDER BY id, title", 11, ReasonUnexpectedToken},
+		{"assignee is", 11, ReasonUnexpectedEnd},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.query, testNow)
+
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("%q: SyntaxError가 발생해야 함. 실제: %v", tt.query, err)
+			continue
+		}
+		if syntaxErr.Position != tt.position || syntaxErr.Reason != tt.reason {
+			t.Errorf("%q: 예상 위치 %d(%s), 실제 %d(%s) %s",
+				tt.query, tt.position, tt.reason, syntaxErr.Position, syntaxErr.Reason, syntaxErr.Message)
+		}
+	}
+}
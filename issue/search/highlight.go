@@ -0,0 +1,82 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	HighlightStart = "<em>"
+	HighlightEnd   = "</em>"
+
+	// 첫 일치 위치 앞뒤로 보여줄 글자 수
+	snippetRadius = 30
+	ellipsis      = "…"
+)
+
+// 검색어가 나온 부분을 <em></em>으로 감싼 발췌문을 만든다.
+// 일치하는 부분이 없으면 false를 반환한다.
+func Highlight(text string, terms []string) (string, bool) {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		termRunes := []rune(term)
+		for i := 0; i+len(termRunes) <= len(lower); i++ {
+			if !matchesAt(lower, termRunes, i) {
+				continue
+			}
+			for j := i; j < i+len(termRunes); j++ {
+				marked[j] = true
+			}
+			if first == -1 || i < first {
+				first = i
+			}
+		}
+	}
+	if first == -1 {
+		return "", false
+	}
+
+	start := max(first-snippetRadius, 0)
+	end := min(first+snippetRadius, len(runes))
+
+	var snippet strings.Builder
+	if start > 0 {
+		snippet.WriteString(ellipsis)
+	}
+	for i := start; i < end; i++ {
+		if marked[i] && (i == start || !marked[i-1]) {
+			snippet.WriteString(HighlightStart)
+		}
+		snippet.WriteRune(runes[i])
+		if marked[i] && (i == end-1 || !marked[i+1]) {
+			snippet.WriteString(HighlightEnd)
+		}
+	}
+	if end < len(runes) {
+		snippet.WriteString(ellipsis)
+	}
+	return snippet.String(), true
+}
+
+// n-gram이 아닌 검색어는 단어 단위로 색인되므로 단어 경계에서 시작하고 끝날 때만 일치로 본다
+func matchesAt(text, term []rune, at int) bool {
+	for i, r := range term {
+		if text[at+i] != r {
+			return false
+		}
+	}
+	if isNgramRune(term[0]) {
+		return true
+	}
+
+	before := at == 0 || !isTermRune(text[at-1]) || isNgramRune(text[at-1])
+	after := at+len(term) == len(text) || !isTermRune(text[at+len(term)]) || isNgramRune(text[at+len(term)])
+	return before && after
+}
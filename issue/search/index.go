@@ -0,0 +1,154 @@
+package search
+
+import (
+	"math"
+	"sort"
+)
+
+type Field string
+
+const (
+	FieldTitle       Field = "title"
+	FieldDescription Field = "description"
+)
+
+// 제목에 나온 검색어는 설명에 나온 것보다 관련도에 더 크게 반영한다
+var fieldWeights = map[Field]float64{
+	FieldTitle:       2,
+	FieldDescription: 1,
+}
+
+// 색인어 하나가 문서의 한 필드에 나온 횟수
+type Posting struct {
+	DocumentID uint
+	Field      Field
+	Frequency  int
+}
+
+type Hit struct {
+	DocumentID uint
+	Score      float64
+}
+
+// 문서의 필드별 색인어를 Posting 목록으로 만든다
+func DocumentPostings(id uint, title, description string) map[string][]Posting {
+	postings := map[string][]Posting{}
+	for _, field := range []struct {
+		name Field
+		text string
+	}{
+		{FieldTitle, title},
+		{FieldDescription, description},
+	} {
+		for term, frequency := range Terms(field.text) {
+			postings[term] = append(postings[term], Posting{DocumentID: id, Field: field.name, Frequency: frequency})
+		}
+	}
+	return postings
+}
+
+// 모든 검색어를 포함한 문서만 남기고 TF-IDF로 점수를 매긴다.
+// 점수가 높은 순, 같으면 문서 ID 순으로 정렬한다.
+func Rank(terms []string, postings map[string][]Posting, totalDocuments int) []Hit {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	scores := map[uint]float64{}
+	matchedTerms := map[uint]int{}
+	for _, term := range terms {
+		documents := map[uint]float64{}
+		for _, posting := range postings[term] {
+			documents[posting.DocumentID] += fieldWeights[posting.Field] * (1 + math.Log(float64(posting.Frequency)))
+		}
+
+		idf := math.Log(1 + float64(totalDocuments)/float64(max(len(documents), 1)))
+		for id, weight := range documents {
+			scores[id] += idf * weight
+			matchedTerms[id]++
+		}
+	}
+
+	var hits []Hit
+	for id, score := range scores {
+		if matchedTerms[id] == len(terms) {
+			hits = append(hits, Hit{DocumentID: id, Score: score})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].DocumentID < hits[j].DocumentID
+	})
+	return hits
+}
+
+// 메모리 역색인. 동시성 제어는 사용하는 쪽에서 한다.
+type Index struct {
+	postings  map[string]map[uint][]Posting
+	documents map[uint][]string
+}
+
+func NewIndex() *Index {
+	return &Index{
+		postings:  map[string]map[uint][]Posting{},
+		documents: map[uint][]string{},
+	}
+}
+
+// 문서를 색인한다. 이미 색인된 문서는 기존 색인어를 지우고 다시 색인한다.
+func (ix *Index) Put(id uint, title, description string) {
+	ix.Remove(id)
+
+	for term, postings := range DocumentPostings(id, title, description) {
+		if ix.postings[term] == nil {
+			ix.postings[term] = map[uint][]Posting{}
+		}
+		ix.postings[term][id] = postings
+		ix.documents[id] = append(ix.documents[id], term)
+	}
+	if _, ok := ix.documents[id]; !ok {
+		ix.documents[id] = nil
+	}
+}
+
+func (ix *Index) Remove(id uint) {
+	for _, term := range ix.documents[id] {
+		delete(ix.postings[term], id)
+		if len(ix.postings[term]) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+	delete(ix.documents, id)
+}
+
+func (ix *Index) Search(text string) []Hit {
+	return ix.SearchWhere(text, nil)
+}
+
+// include가 false를 돌려주는 문서는 검색 결과뿐 아니라 문서 수와 문서 빈도에서도 빠진다.
+// include가 nil이면 모든 문서를 대상으로 한다.
+func (ix *Index) SearchWhere(text string, include func(id uint) bool) []Hit {
+	terms := QueryTerms(text)
+	postings := map[string][]Posting{}
+	for _, term := range terms {
+		for id, documentPostings := range ix.postings[term] {
+			if include != nil && !include(id) {
+				continue
+			}
+			postings[term] = append(postings[term], documentPostings...)
+		}
+	}
+
+	totalDocuments := len(ix.documents)
+	if include != nil {
+		totalDocuments = 0
+		for id := range ix.documents {
+			if include(id) {
+				totalDocuments++
+			}
+		}
+	}
+	return Rank(terms, postings, totalDocuments)
+}
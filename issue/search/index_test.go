@@ -0,0 +1,93 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueryTerms_한글은_bigram_영문은_단어(t *testing.T) {
+	tests := map[string][]string{
+		"로그인":           {"로그", "그인"},
+		"로그인 API오류":     {"로그", "그인", "api", "오류"},
+		"Login-Page 버그": {"login", "page", "버그"},
+		"키":             {"키"},
+		"!!!":           nil,
+	}
+
+	for text, expected := range tests {
+		if got := QueryTerms(text); !reflect.DeepEqual(got, expected) {
+			t.Errorf("%q: 예상 %v, 실제 %v", text, expected, got)
+		}
+	}
+}
+
+func TestIndex_띄어쓰기_없는_한글_검색(t *testing.T) {
+	index := NewIndex()
+	index.Put(1, "로그인페이지오류", "")
+	index.Put(2, "결제 오류", "")
+
+	hits := index.Search("로그인 오류")
+	if len(hits) != 1 || hits[0].DocumentID != 1 {
+		t.Errorf("붙여 쓴 제목도 검색되어야 함. 실제: %+v", hits)
+	}
+
+	hits = index.Search("오류")
+	if len(hits) != 2 {
+		t.Errorf("두 문서 모두 검색되어야 함. 실제: %+v", hits)
+	}
+}
+
+func TestIndex_제목_일치가_설명_일치보다_관련도가_높음(t *testing.T) {
+	index := NewIndex()
+	index.Put(1, "화면 개선", "결제 버그와 관련 있음")
+	index.Put(2, "결제 버그", "재현 방법 첨부")
+	index.Put(3, "문서 정리", "")
+
+	hits := index.Search("결제 버그")
+	if len(hits) != 2 || hits[0].DocumentID != 2 || hits[1].DocumentID != 1 {
+		t.Errorf("제목에 나온 이슈가 먼저여야 함. 실제: %+v", hits)
+	}
+	if hits[0].Score <= hits[1].Score {
+		t.Errorf("점수는 내림차순이어야 함. 실제: %+v", hits)
+	}
+}
+
+func TestIndex_다시_색인하면_이전_색인어는_지워짐(t *testing.T) {
+	index := NewIndex()
+	index.Put(1, "로그인 버그", "")
+	index.Put(1, "결제 버그", "")
+
+	if hits := index.Search("로그인"); len(hits) != 0 {
+		t.Errorf("이전 제목으로는 검색되지 않아야 함. 실제: %+v", hits)
+	}
+	if hits := index.Search("결제"); len(hits) != 1 {
+		t.Errorf("새 제목으로 검색되어야 함. 실제: %+v", hits)
+	}
+
+	index.Remove(1)
+	if hits := index.Search("버그"); len(hits) != 0 {
+		t.Errorf("삭제된 문서는 검색되지 않아야 함. 실제: %+v", hits)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		text     string
+		query    string
+		expected string
+		found    bool
+	}{
+		{"로그인페이지 오류", "로그인", "<em>로그인</em>페이지 오류", true},
+		{"Login failed on login page", "LOGIN", "<em>Login</em> failed on <em>login</em> page", true},
+		{"logins are slow", "login", "", false},
+		{"앞부분이 아주 길게 이어지는 설명입니다. 한참 뒤에 나오는 결제 오류 재현 방법을 여기에 적습니다. 그리고 뒤에도 문장이 더 있습니다.", "결제",
+			"…이 아주 길게 이어지는 설명입니다. 한참 뒤에 나오는 <em>결제</em> 오류 재현 방법을 여기에 적습니다. 그리고 뒤에도…", true},
+	}
+
+	for _, tt := range tests {
+		snippet, found := Highlight(tt.text, QueryTerms(tt.query))
+		if found != tt.found || snippet != tt.expected {
+			t.Errorf("%q: 예상 %q(%v), 실제 %q(%v)", tt.query, tt.expected, tt.found, snippet, found)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package search
+
+import (
+	"unicode"
+)
+
+// 한글·한자·가나처럼 띄어쓰기 없이 이어 쓰는 문자열은 n-gram으로 나누고,
+// 그 밖의 문자(영문, 숫자 등)는 단어 단위로 나눈다.
+func isNgramRune(r rune) bool {
+	return unicode.In(r, unicode.Hangul, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+func isTermRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+type segment struct {
+	runes []rune
+	ngram bool
+}
+
+// 소문자로 바꾼 뒤 문자 종류가 바뀌는 지점과 구분 문자에서 자른다
+func segments(text string) []segment {
+	var (
+		result  []segment
+		current []rune
+		ngram   bool
+	)
+	flush := func() {
+		if len(current) > 0 {
+			result = append(result, segment{runes: current, ngram: ngram})
+			current = nil
+		}
+	}
+
+	for _, r := range text {
+		if !isTermRune(r) {
+			flush()
+			continue
+		}
+		r = unicode.ToLower(r)
+		if len(current) > 0 && isNgramRune(r) != ngram {
+			flush()
+		}
+		ngram = isNgramRune(r)
+		current = append(current, r)
+	}
+	flush()
+	return result
+}
+
+// 문서의 색인어와 출현 횟수. n-gram 구간은 한 글자 검색도 찾을 수 있도록 unigram과 bigram을 모두 색인한다.
+func Terms(text string) map[string]int {
+	terms := map[string]int{}
+	for _, seg := range segments(text) {
+		if !seg.ngram {
+			terms[string(seg.runes)]++
+			continue
+		}
+		for i := range seg.runes {
+			terms[string(seg.runes[i])]++
+			if i+1 < len(seg.runes) {
+				terms[string(seg.runes[i:i+2])]++
+			}
+		}
+	}
+	return terms
+}
+
+// 검색어의 색인어. n-gram 구간은 bigram으로만 찾고, 한 글자일 때만 unigram을 쓴다.
+// 중복은 제거하고 처음 나온 순서를 유지한다.
+func QueryTerms(text string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	add := func(term string) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	for _, seg := range segments(text) {
+		if !seg.ngram || len(seg.runes) == 1 {
+			add(string(seg.runes))
+			continue
+		}
+		for i := 0; i+1 < len(seg.runes); i++ {
+			add(string(seg.runes[i : i+2]))
+		}
+	}
+	return terms
+}
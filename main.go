@@ -1,27 +1,248 @@
 package main
 
 import (
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	commentApp "issue-service-aoroa/comment/application"
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	commentPresentation "issue-service-aoroa/comment/presentation"
+	"issue-service-aoroa/config"
+	"issue-service-aoroa/database"
+	"issue-service-aoroa/database/migrations"
+	"issue-service-aoroa/internal/httpx"
 	issuePresentation "issue-service-aoroa/issue/presentation"
 	issueInfra "issue-service-aoroa/issue/infrastructure"
 	issueApp "issue-service-aoroa/issue/application"
+	issueModel "issue-service-aoroa/issue/model"
+	mentionApp "issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	mentionPresentation "issue-service-aoroa/mention/presentation"
+	userApp "issue-service-aoroa/user/application"
 	userInfra "issue-service-aoroa/user/infrastructure"
+	userPresentation "issue-service-aoroa/user/presentation"
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
-	userRepo := userInfra.NewUserRepository()
-	issueRepo := issueInfra.NewIssueRepository()
-	issueService := issueApp.NewIssueService(issueRepo, userRepo)
+	cfg := config.Load()
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("마이그레이션 실패: %v", err)
+		}
+		return
+	}
+
+	if err := loadWorkflow(cfg); err != nil {
+		log.Fatalf("워크플로 초기화 실패: %v", err)
+	}
+
+	repos, err := newRepositories(cfg)
+	if err != nil {
+		log.Fatalf("저장소 초기화 실패: %v", err)
+	}
+	for _, storage := range repos.storage {
+		defer storage.Close()
+	}
+
+	mentionService := mentionApp.NewMentionService(repos.mentions, repos.issues, repos.users)
+	mentionController := mentionPresentation.NewMentionController(mentionService)
+	issueService := issueApp.NewIssueService(repos.issues, repos.users, repos.comments, mentionService)
+	userController := userPresentation.NewUserController(userApp.NewUserService(repos.users, issueService))
 	issueController := issuePresentation.NewIssueController(issueService)
+	commentService := commentApp.NewCommentService(repos.comments, repos.issues, repos.users, mentionService)
+	commentController := commentPresentation.NewCommentController(commentService)
+
+	authenticator, err := newAuthenticator(cfg, repos.users)
+	if err != nil {
+		log.Fatalf("인증 설정 오류: %v", err)
+	}
 
 	router := gin.Default()
+	router.Use(httpx.RequestID())
+	router.Use(httpx.ErrorHandler(issuePresentation.ErrorMapping, commentPresentation.ErrorMapping, userPresentation.ErrorMapping))
+	router.Use(httpx.Authenticate(authenticator))
 
 	router.POST("/issue", issueController.CreateIssue)
 	router.GET("/issues", issueController.GetIssues)
+	router.GET("/issues/search", issueController.SearchIssues)
 	router.GET("/issue/:id", issueController.GetIssueByID)
 	router.PATCH("/issue/:id", issueController.UpdateIssue)
+	router.DELETE("/issue/:id", issueController.DeleteIssue)
+	router.POST("/issue/:id/restore", issueController.RestoreIssue)
+	router.GET("/issue/:id/transitions", issueController.GetIssueTransitions)
+	router.GET("/issue/:id/history", issueController.GetIssueHistory)
+	router.POST("/issue/:id/transitions/:name", issueController.TransitionIssue)
+	router.POST("/issue/:id/comments", commentController.AddComment)
+	router.GET("/issue/:id/comments", commentController.GetComments)
+	router.PATCH("/issue/:id/comments/:commentId", commentController.EditComment)
+	router.DELETE("/issue/:id/comments/:commentId", commentController.DeleteComment)
+	router.GET("/issue/:id/comments/:commentId/revisions", commentController.GetCommentRevisions)
+	router.POST("/users", userController.CreateUser)
+	router.GET("/users", userController.GetUsers)
+	router.GET("/users/:id", userController.GetUserByID)
+	router.PATCH("/users/:id", userController.UpdateUser)
+	router.POST("/users/:id/deactivate", userController.DeactivateUser)
+	router.GET("/users/:id/mentions", mentionController.GetUserMentions)
+
+	admin := router.Group("/admin")
+	admin.DELETE("/issue/:id", issueController.PurgeIssue)
+	admin.POST("/issue/:id/reopen", issueController.ReopenIssue)
+
+	router.Run(":" + cfg.Port)
+}
+
+// storage는 종료할 때 닫아야 하는 저장 매체이며, 메모리 저장소이면 비어 있다
+type repositories struct {
+	users    userInfra.UserRepository
+	issues   issueInfra.IssueRepository
+	comments commentInfra.CommentRepository
+	mentions mentionInfra.MentionRepository
+	storage  []io.Closer
+}
 
-	router.Run(":8080")
+func newRepositories(cfg config.Config) (repositories, error) {
+	switch cfg.Storage {
+	case config.StorageMemory:
+		return repositories{
+			users:    userInfra.NewUserRepository(),
+			issues:   issueInfra.NewIssueRepository(),
+			comments: commentInfra.NewCommentRepository(),
+			mentions: mentionInfra.NewMentionRepository(),
+		}, nil
+	case config.StorageSQLite:
+		db, err := openMigratedDatabase(cfg)
+		if err != nil {
+			return repositories{}, err
+		}
+		userRepo, err := userInfra.NewSQLiteUserRepository(db)
+		if err != nil {
+			db.Close()
+			return repositories{}, err
+		}
+		issueRepo, err := issueInfra.NewSQLiteIssueRepository(db)
+		if err != nil {
+			db.Close()
+			return repositories{}, err
+		}
+		return repositories{
+			users:    userRepo,
+			issues:   issueRepo,
+			comments: commentInfra.NewSQLiteCommentRepository(db),
+			mentions: mentionInfra.NewSQLiteMentionRepository(db),
+			storage:  []io.Closer{db},
+		}, nil
+	case config.StorageEventLog:
+		// 이슈만 이벤트 로그에 기록하고, 사용자·댓글·멘션은 같은 SQLite 파일에 둔다
+		db, err := openMigratedDatabase(cfg)
+		if err != nil {
+			return repositories{}, err
+		}
+		userRepo, err := userInfra.NewSQLiteUserRepository(db)
+		if err != nil {
+			db.Close()
+			return repositories{}, err
+		}
+		eventLog, err := issueInfra.OpenFileEventLog(cfg.EventLogPath)
+		if err != nil {
+			db.Close()
+			return repositories{}, err
+		}
+		issueRepo, err := issueInfra.NewEventSourcedIssueRepository(eventLog)
+		if err != nil {
+			eventLog.Close()
+			db.Close()
+			return repositories{}, err
+		}
+		return repositories{
+			users:    userRepo,
+			issues:   issueRepo,
+			comments: commentInfra.NewSQLiteCommentRepository(db),
+			mentions: mentionInfra.NewSQLiteMentionRepository(db),
+			storage:  []io.Closer{eventLog, db},
+		}, nil
+	default:
+		return repositories{}, fmt.Errorf("지원하지 않는 저장소 유형입니다: %s", cfg.Storage)
+	}
 }
 
+// JWT 서명 키와 API 키가 모두 없으면 어떤 요청도 인증할 수 없으므로 서버를 시작하지 않는다
+func newAuthenticator(cfg config.Config, users userInfra.UserRepository) (userApp.Authenticator, error) {
+	apiKeys, err := cfg.APIKeyUsers()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.JWTSecret == "" && len(apiKeys) == 0 {
+		return nil, fmt.Errorf("ISSUE_JWT_SECRET 또는 ISSUE_API_KEYS를 설정해야 합니다")
+	}
+	return userApp.NewAuthenticator(users, userApp.AuthConfig{
+		JWTSecret: []byte(cfg.JWTSecret),
+		APIKeys:   apiKeys,
+	}), nil
+}
+
+// 워크플로 파일이 지정되지 않으면 내장된 기본 워크플로를 그대로 쓴다
+func loadWorkflow(cfg config.Config) error {
+	if cfg.WorkflowPath == "" {
+		return nil
+	}
+	data, err := os.ReadFile(cfg.WorkflowPath)
+	if err != nil {
+		return err
+	}
+	workflow, err := issueModel.ParseWorkflow(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", cfg.WorkflowPath, err)
+	}
+	issueModel.SetWorkflow(workflow)
+	return nil
+}
+
+// eventlog 저장소는 이슈를 로그 파일에 두므로 issues 테이블을 참조하는 외래 키를 검사하지 않는다
+func openDatabase(cfg config.Config) (*sql.DB, error) {
+	if cfg.Storage == config.StorageEventLog {
+		return database.OpenSQLiteWithoutForeignKeys(cfg.SQLitePath)
+	}
+	return database.OpenSQLite(cfg.SQLitePath)
+}
+
+func openMigratedDatabase(cfg config.Config) (*sql.DB, error) {
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureMigrated(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func newMigrator(db *sql.DB) (*migrations.Migrator, error) {
+	all, err := migrations.Load()
+	if err != nil {
+		return nil, err
+	}
+	return migrations.NewMigrator(db, all), nil
+}
+
+// 적용되지 않은 마이그레이션이 있으면 HTTP 서버를 시작하지 않는다
+func ensureMigrated(db *sql.DB) error {
+	migrator, err := newMigrator(db)
+	if err != nil {
+		return err
+	}
+	pending, err := migrator.Pending()
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("적용되지 않은 마이그레이션이 %d개 있습니다. 'migrate up'을 먼저 실행하세요", len(pending))
+	}
+	return nil
+}
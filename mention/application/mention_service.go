@@ -0,0 +1,100 @@
+package application
+
+import (
+	"time"
+
+	commentModel "issue-service-aoroa/comment/model"
+	issueInfra "issue-service-aoroa/issue/infrastructure"
+	issueModel "issue-service-aoroa/issue/model"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	"issue-service-aoroa/mention/model"
+	userApp "issue-service-aoroa/user/application"
+	userInfra "issue-service-aoroa/user/infrastructure"
+)
+
+// 이슈 설명과 댓글 본문의 @멘션을 사용자로 해석해 기록한다.
+// 이슈 서비스와 댓글 서비스가 본문을 저장할 때마다 호출하며, 없는 사용자를 가리키는 멘션은 무시한다.
+// 이슈를 완전히 삭제하면 이슈 서비스가 DeleteIssueMentions로 그 이슈의 멘션을 모두 지운다
+type MentionService interface {
+	RecordIssueMentions(issue issueModel.Issue) error
+	RecordCommentMentions(comment commentModel.Comment) error
+	DeleteIssueMentions(issueID uint) error
+	GetUserMentions(userID uint) ([]model.Mention, error)
+}
+
+type mentionService struct {
+	mentionRepo mentionInfra.MentionRepository
+	issueRepo   issueInfra.IssueRepository
+	userRepo    userInfra.UserRepository
+}
+
+func NewMentionService(mentionRepo mentionInfra.MentionRepository, issueRepo issueInfra.IssueRepository, userRepo userInfra.UserRepository) MentionService {
+	return &mentionService{
+		mentionRepo: mentionRepo,
+		issueRepo:   issueRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (s *mentionService) RecordIssueMentions(issue issueModel.Issue) error {
+	return s.record(issue.ID, nil, issue.Description)
+}
+
+// 삭제된 댓글은 본문이 비어 있으므로 멘션도 모두 지워진다
+func (s *mentionService) RecordCommentMentions(comment commentModel.Comment) error {
+	commentID := comment.ID
+	return s.record(comment.IssueID, &commentID, comment.Body)
+}
+
+func (s *mentionService) DeleteIssueMentions(issueID uint) error {
+	return s.mentionRepo.DeleteByIssue(issueID)
+}
+
+// 삭제된 이슈의 멘션은 복원되기 전까지 보이지 않는다
+func (s *mentionService) GetUserMentions(userID uint) ([]model.Mention, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, userApp.ErrUserNotFound
+	}
+
+	all, err := s.mentionRepo.ListByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := map[uint]bool{}
+	mentions := []model.Mention{}
+	for _, mention := range all {
+		shown, checked := visible[mention.IssueID]
+		if !checked {
+			issue, err := s.issueRepo.GetByID(mention.IssueID)
+			if err != nil {
+				return nil, err
+			}
+			shown = issue != nil && !issue.IsDeleted()
+			visible[mention.IssueID] = shown
+		}
+		if shown {
+			mentions = append(mentions, mention)
+		}
+	}
+	return mentions, nil
+}
+
+// 본문의 @멘션이 가리킬 수 있는 이름의 사용자만 저장소에서 찾는다
+func (s *mentionService) record(issueID uint, commentID *uint, text string) error {
+	users, err := s.userRepo.GetByNames(model.CandidateNames(text))
+	if err != nil {
+		return err
+	}
+
+	mentioned := model.MentionedUsers(text, users)
+	userIDs := make([]uint, 0, len(mentioned))
+	for _, user := range mentioned {
+		userIDs = append(userIDs, user.ID)
+	}
+	return s.mentionRepo.Replace(issueID, commentID, userIDs, time.Now())
+}
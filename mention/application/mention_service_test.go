@@ -0,0 +1,174 @@
+package application
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"issue-service-aoroa/database"
+	"issue-service-aoroa/database/migrations"
+	issueInfra "issue-service-aoroa/issue/infrastructure"
+	issueModel "issue-service-aoroa/issue/model"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	"issue-service-aoroa/mention/model"
+	userApp "issue-service-aoroa/user/application"
+	userInfra "issue-service-aoroa/user/infrastructure"
+	userModel "issue-service-aoroa/user/model"
+)
+
+type testRepositories struct {
+	mentions mentionInfra.MentionRepository
+	issues   issueInfra.IssueRepository
+	users    userInfra.UserRepository
+}
+
+func newMemoryRepositories(t *testing.T) testRepositories {
+	return testRepositories{
+		mentions: mentionInfra.NewMentionRepository(),
+		issues:   issueInfra.NewIssueRepository(),
+		users:    userInfra.NewUserRepository(),
+	}
+}
+
+func newSQLiteRepositories(t *testing.T) testRepositories {
+	db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("SQLite 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	all, err := migrations.Load()
+	if err != nil {
+		t.Fatalf("마이그레이션 로드 실패: %v", err)
+	}
+	if _, err := migrations.NewMigrator(db, all).Up(); err != nil {
+		t.Fatalf("마이그레이션 적용 실패: %v", err)
+	}
+
+	userRepo, err := userInfra.NewSQLiteUserRepository(db)
+	if err != nil {
+		t.Fatalf("사용자 저장소 생성 실패: %v", err)
+	}
+	issueRepo, err := issueInfra.NewSQLiteIssueRepository(db)
+	if err != nil {
+		t.Fatalf("이슈 저장소 생성 실패: %v", err)
+	}
+	return testRepositories{
+		mentions: mentionInfra.NewSQLiteMentionRepository(db),
+		issues:   issueRepo,
+		users:    userRepo,
+	}
+}
+
+// 모든 저장소 구현체에 대해 동일한 테스트를 실행한다
+func forEachRepository(t *testing.T, test func(t *testing.T, service MentionService, repos testRepositories)) {
+	factories := []struct {
+		name string
+		new  func(t *testing.T) testRepositories
+	}{
+		{name: "memory", new: newMemoryRepositories},
+		{name: "sqlite", new: newSQLiteRepositories},
+	}
+	for _, factory := range factories {
+		t.Run(factory.name, func(t *testing.T) {
+			repos := factory.new(t)
+			test(t, NewMentionService(repos.mentions, repos.issues, repos.users), repos)
+		})
+	}
+}
+
+func createIssue(t *testing.T, repos testRepositories, description string) issueModel.Issue {
+	t.Helper()
+	issue, err := issueModel.NewIssue("로그인 오류", description, nil)
+	if err != nil {
+		t.Fatalf("이슈 생성 실패: %v", err)
+	}
+	created, err := repos.issues.Create(*issue, nil)
+	if err != nil {
+		t.Fatalf("이슈 저장 실패: %v", err)
+	}
+	return created
+}
+
+func TestRecordIssueMentions_수정하면_멘션을_교체(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service MentionService, repos testRepositories) {
+		issue := createIssue(t, repos, "@김개발님과 @이디자인님 확인 부탁드립니다")
+		if err := service.RecordIssueMentions(issue); err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		first, _ := service.GetUserMentions(1)
+		if len(first) != 1 || first[0].IssueID != issue.ID || first[0].Source() != model.SourceIssue {
+			t.Fatalf("이슈 설명의 멘션이 기록되어야 함: %+v", first)
+		}
+
+		issue.Description = "@김개발 혼자 보시면 됩니다. @박기획"
+		if err := service.RecordIssueMentions(issue); err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+
+		kept, _ := service.GetUserMentions(1)
+		if len(kept) != 1 || kept[0].ID != first[0].ID || !kept[0].CreatedAt.Equal(first[0].CreatedAt) {
+			t.Errorf("계속 언급된 사용자의 멘션은 그대로 남아야 함: %+v", kept)
+		}
+		if removed, _ := service.GetUserMentions(2); len(removed) != 0 {
+			t.Errorf("더 이상 언급되지 않은 사용자의 멘션은 지워져야 함: %+v", removed)
+		}
+		if added, _ := service.GetUserMentions(3); len(added) != 1 {
+			t.Errorf("새로 언급된 사용자의 멘션이 추가되어야 함: %+v", added)
+		}
+	})
+}
+
+// 멘션을 기록할 때 사용자 전체를 읽지 않는지 확인하기 위해 GetAll을 막는다
+type noGetAllUserRepository struct {
+	userInfra.UserRepository
+}
+
+func (r noGetAllUserRepository) GetAll() ([]userModel.User, error) {
+	return nil, errors.New("멘션 기록에 사용자 전체 조회를 쓰면 안 됨")
+}
+
+func TestRecordIssueMentions_언급된_이름의_사용자만_조회(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, _ MentionService, repos testRepositories) {
+		service := NewMentionService(repos.mentions, repos.issues, noGetAllUserRepository{repos.users})
+		issue := createIssue(t, repos, "@이디자인님 확인 부탁드립니다. 메일은 kim@example.com")
+		if err := service.RecordIssueMentions(issue); err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if mentions, _ := service.GetUserMentions(2); len(mentions) != 1 {
+			t.Errorf("언급된 사용자의 멘션이 기록되어야 함: %+v", mentions)
+		}
+	})
+}
+
+func TestGetUserMentions_삭제된_이슈의_멘션은_숨김(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service MentionService, repos testRepositories) {
+		older := createIssue(t, repos, "@이디자인 확인")
+		newer := createIssue(t, repos, "@이디자인 이것도 확인")
+		service.RecordIssueMentions(older)
+		service.RecordIssueMentions(newer)
+
+		mentions, _ := service.GetUserMentions(2)
+		if len(mentions) != 2 || mentions[0].IssueID != newer.ID {
+			t.Fatalf("최근 멘션부터 반환되어야 함: %+v", mentions)
+		}
+
+		newer.Delete(time.Now())
+		if _, err := repos.issues.Update(newer.ID, newer, nil); err != nil {
+			t.Fatalf("이슈 삭제 실패: %v", err)
+		}
+		mentions, _ = service.GetUserMentions(2)
+		if len(mentions) != 1 || mentions[0].IssueID != older.ID {
+			t.Errorf("삭제된 이슈의 멘션은 보이지 않아야 함: %+v", mentions)
+		}
+	})
+}
+
+func TestGetUserMentions_실패_없는_사용자(t *testing.T) {
+	service := NewMentionService(mentionInfra.NewMentionRepository(), issueInfra.NewIssueRepository(), userInfra.NewUserRepository())
+
+	if _, err := service.GetUserMentions(999); !errors.Is(err, userApp.ErrUserNotFound) {
+		t.Errorf("없는 사용자는 USER_NOT_FOUND여야 함. 실제: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package infrastructure
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	mentionModel "issue-service-aoroa/mention/model"
+)
+
+// 멘션은 이슈 설명 또는 댓글 하나를 단위로 통째로 교체한다.
+// Replace는 commentID가 nil이면 이슈 설명의 멘션을 교체하며, 계속 언급된 사용자의 멘션은 처음 기록된 시각을 유지한다.
+// ListByUser는 최근에 기록된 멘션부터 반환한다. DeleteByIssue는 이슈 설명과 댓글의 멘션을 모두 지운다
+type MentionRepository interface {
+	Replace(issueID uint, commentID *uint, userIDs []uint, at time.Time) error
+	ListByUser(userID uint) ([]mentionModel.Mention, error)
+	DeleteByIssue(issueID uint) error
+}
+
+type mentionRepository struct {
+	mu       sync.RWMutex
+	mentions []mentionModel.Mention
+	lastID   uint
+}
+
+func NewMentionRepository() MentionRepository {
+	return &mentionRepository{mentions: []mentionModel.Mention{}}
+}
+
+func (r *mentionRepository) Replace(issueID uint, commentID *uint, userIDs []uint, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := make([]mentionModel.Mention, 0, len(r.mentions))
+	existing := map[uint]bool{}
+	for _, mention := range r.mentions {
+		if sameTarget(mention, issueID, commentID) {
+			if !slices.Contains(userIDs, mention.UserID) {
+				continue
+			}
+			existing[mention.UserID] = true
+		}
+		kept = append(kept, mention)
+	}
+
+	for _, userID := range userIDs {
+		if existing[userID] {
+			continue
+		}
+		r.lastID++
+		kept = append(kept, cloneMention(mentionModel.Mention{
+			ID:        r.lastID,
+			UserID:    userID,
+			IssueID:   issueID,
+			CommentID: commentID,
+			CreatedAt: at,
+		}))
+		existing[userID] = true
+	}
+	r.mentions = kept
+	return nil
+}
+
+func (r *mentionRepository) ListByUser(userID uint) ([]mentionModel.Mention, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// mentions는 ID 오름차순으로 쌓이므로 뒤에서부터 읽는다
+	mentions := []mentionModel.Mention{}
+	for i := len(r.mentions) - 1; i >= 0; i-- {
+		if r.mentions[i].UserID == userID {
+			mentions = append(mentions, cloneMention(r.mentions[i]))
+		}
+	}
+	return mentions, nil
+}
+
+func (r *mentionRepository) DeleteByIssue(issueID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.mentions = slices.DeleteFunc(r.mentions, func(mention mentionModel.Mention) bool {
+		return mention.IssueID == issueID
+	})
+	return nil
+}
+
+func sameTarget(mention mentionModel.Mention, issueID uint, commentID *uint) bool {
+	if mention.IssueID != issueID {
+		return false
+	}
+	if mention.CommentID == nil || commentID == nil {
+		return mention.CommentID == nil && commentID == nil
+	}
+	return *mention.CommentID == *commentID
+}
+
+func cloneMention(mention mentionModel.Mention) mentionModel.Mention {
+	if mention.CommentID != nil {
+		commentID := *mention.CommentID
+		mention.CommentID = &commentID
+	}
+	return mention
+}
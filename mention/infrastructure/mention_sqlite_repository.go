@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"slices"
+	"time"
+
+	"issue-service-aoroa/database"
+	mentionModel "issue-service-aoroa/mention/model"
+)
+
+type sqliteMentionRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteMentionRepository(db *sql.DB) MentionRepository {
+	return &sqliteMentionRepository{db: db}
+}
+
+func (r *sqliteMentionRepository) Replace(issueID uint, commentID *uint, userIDs []uint, at time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// comment_id가 NULL인 이슈 설명의 멘션도 함께 찾도록 = 대신 IS로 비교한다
+	rows, err := tx.Query(`SELECT id, user_id FROM mentions WHERE issue_id = ? AND comment_id IS ?`, issueID, commentID)
+	if err != nil {
+		return err
+	}
+	existing := map[uint]uint{}
+	for rows.Next() {
+		var id, userID uint
+		if err := rows.Scan(&id, &userID); err != nil {
+			rows.Close()
+			return err
+		}
+		existing[userID] = id
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for userID, id := range existing {
+		if !slices.Contains(userIDs, userID) {
+			if _, err := tx.Exec(`DELETE FROM mentions WHERE id = ?`, id); err != nil {
+				return err
+			}
+		}
+	}
+	for _, userID := range userIDs {
+		if _, ok := existing[userID]; ok {
+			continue
+		}
+		if _, err := tx.Exec(
+			`INSERT INTO mentions (user_id, issue_id, comment_id, created_at) VALUES (?, ?, ?, ?)`,
+			userID, issueID, commentID, database.FormatTime(at),
+		); err != nil {
+			return err
+		}
+		existing[userID] = 0
+	}
+	return tx.Commit()
+}
+
+func (r *sqliteMentionRepository) ListByUser(userID uint) ([]mentionModel.Mention, error) {
+	rows, err := r.db.Query(`SELECT id, user_id, issue_id, comment_id, created_at FROM mentions WHERE user_id = ? ORDER BY id DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mentions := []mentionModel.Mention{}
+	for rows.Next() {
+		var (
+			mention   mentionModel.Mention
+			commentID sql.NullInt64
+			createdAt string
+		)
+		if err := rows.Scan(&mention.ID, &mention.UserID, &mention.IssueID, &commentID, &createdAt); err != nil {
+			return nil, err
+		}
+		if commentID.Valid {
+			id := uint(commentID.Int64)
+			mention.CommentID = &id
+		}
+		if mention.CreatedAt, err = database.ParseTime(createdAt); err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, mention)
+	}
+	return mentions, rows.Err()
+}
+
+func (r *sqliteMentionRepository) DeleteByIssue(issueID uint) error {
+	_, err := r.db.Exec(`DELETE FROM mentions WHERE issue_id = ?`, issueID)
+	return err
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	userModel "issue-service-aoroa/user/model"
+)
+
+const (
+	SourceIssue   = "ISSUE"
+	SourceComment = "COMMENT"
+)
+
+// 이슈 설명이나 댓글 본문에서 사용자를 언급한 기록. CommentID가 nil이면 이슈 설명의 멘션이다
+type Mention struct {
+	ID        uint
+	UserID    uint
+	IssueID   uint
+	CommentID *uint
+	CreatedAt time.Time
+}
+
+func (m Mention) Source() string {
+	if m.CommentID != nil {
+		return SourceComment
+	}
+	return SourceIssue
+}
+
+// text의 @멘션을 users에서 찾아 처음 언급된 순서대로 중복 없이 반환한다.
+// "@김개발님"처럼 이름 뒤에 조사나 호칭이 붙을 수 있으므로 @ 뒤의 단어가 이름으로 시작하면 가장 긴 이름과 일치시킨다.
+// 이메일 주소처럼 @ 바로 앞이 글자나 숫자이면 멘션으로 보지 않으며, 공백이 있는 이름은 언급할 수 없다
+func MentionedUsers(text string, users []userModel.User) []userModel.User {
+	candidates := make([]userModel.User, 0, len(users))
+	for _, user := range users {
+		if user.Name != "" && !strings.ContainsFunc(user.Name, unicode.IsSpace) {
+			candidates = append(candidates, user)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return utf8.RuneCountInString(candidates[i].Name) > utf8.RuneCountInString(candidates[j].Name)
+	})
+
+	mentioned := []userModel.User{}
+	seen := map[uint]bool{}
+	for _, word := range mentionWords(text) {
+		for _, user := range candidates {
+			if strings.HasPrefix(word, user.Name) {
+				if !seen[user.ID] {
+					seen[user.ID] = true
+					mentioned = append(mentioned, user)
+				}
+				break
+			}
+		}
+	}
+	return mentioned
+}
+
+// MentionedUsers에 넘길 사용자를 저장소에서 이름으로 찾을 수 있도록, text의 @멘션이 가리킬 수 있는 이름을 모두 반환한다.
+// 이름 뒤에 조사나 호칭이 붙을 수 있으므로 @ 뒤의 단어를 사용자 이름 최대 길이까지 앞에서부터 자른 것들이 후보가 된다
+func CandidateNames(text string) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	for _, word := range mentionWords(text) {
+		length := 0
+		for i, r := range word {
+			if length == userModel.MaxNameLength {
+				break
+			}
+			length++
+			name := word[:i+utf8.RuneLen(r)]
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// @ 뒤에 이어지는 단어들. 단어는 글자, 숫자와 _ . - 로 이루어진다
+func mentionWords(text string) []string {
+	words := []string{}
+	var previous rune
+	for i, r := range text {
+		if r == '@' && !isWordRune(previous) {
+			rest := text[i+1:]
+			end := strings.IndexFunc(rest, func(r rune) bool { return !isWordRune(r) })
+			if end < 0 {
+				end = len(rest)
+			}
+			if end > 0 {
+				words = append(words, rest[:end])
+			}
+		}
+		previous = r
+	}
+	return words
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	userModel "issue-service-aoroa/user/model"
+)
+
+var users = []userModel.User{
+	{ID: 1, Name: "김개발"},
+	{ID: 2, Name: "이디자인"},
+	{ID: 3, Name: "김개발자"},
+	{ID: 4, Name: "홍 길동"},
+}
+
+func TestMentionedUsers(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []uint
+	}{
+		{"이름만", "@김개발 확인 부탁드립니다", []uint{1}},
+		{"조사가 붙은 이름", "@이디자인님, @김개발에게 전달", []uint{2, 1}},
+		{"가장 긴 이름 우선", "@김개발자 리뷰", []uint{3}},
+		{"중복은 한 번만", "@김개발 @김개발 (@김개발)", []uint{1}},
+		{"이메일은 제외", "kim@김개발.com 으로 메일", []uint{}},
+		{"없는 사용자", "@박기획 @ 혼자 쓴 @", []uint{}},
+		{"공백이 있는 이름은 제외", "@홍 길동", []uint{}},
+		{"줄 시작", "확인\n@이디자인", []uint{2}},
+	}
+
+	for _, tt := range tests {
+		got := MentionedUsers(tt.text, users)
+		ids := []uint{}
+		for _, user := range got {
+			ids = append(ids, user.ID)
+		}
+		if len(ids) != len(tt.want) {
+			t.Errorf("%s: %v이어야 함. 실제: %v", tt.name, tt.want, ids)
+			continue
+		}
+		for i := range ids {
+			if ids[i] != tt.want[i] {
+				t.Errorf("%s: %v이어야 함. 실제: %v", tt.name, tt.want, ids)
+				break
+			}
+		}
+	}
+}
+
+func TestCandidateNames(t *testing.T) {
+	got := CandidateNames("@김개발님 kim@example.com @김개발 @ab")
+	want := []string{"김", "김개", "김개발", "김개발님", "a", "ab"}
+	if len(got) != len(want) {
+		t.Fatalf("%v이어야 함. 실제: %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%v이어야 함. 실제: %v", want, got)
+		}
+	}
+
+	long := CandidateNames("@" + strings.Repeat("가", userModel.MaxNameLength+10))
+	if len(long) != userModel.MaxNameLength {
+		t.Errorf("이름 최대 길이까지만 후보여야 함. 실제: %d개", len(long))
+	}
+}
+
+func TestMention_Source(t *testing.T) {
+	commentID := uint(1)
+	if (Mention{IssueID: 1}).Source() != SourceIssue {
+		t.Error("댓글 ID가 없으면 이슈 설명의 멘션이어야 함")
+	}
+	if (Mention{IssueID: 1, CommentID: &commentID}).Source() != SourceComment {
+		t.Error("댓글 ID가 있으면 댓글의 멘션이어야 함")
+	}
+}
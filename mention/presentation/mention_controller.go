@@ -0,0 +1,63 @@
+package presentation
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/mention/application"
+	"issue-service-aoroa/mention/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 에러 응답은 httpx.ErrorHandler가 만든다
+type MentionController struct {
+	mentionService application.MentionService
+}
+
+func NewMentionController(mentionService application.MentionService) *MentionController {
+	return &MentionController{mentionService: mentionService}
+}
+
+// source가 COMMENT이면 commentId의 댓글, ISSUE이면 이슈 설명에서 언급된 것이다
+type MentionResponse struct {
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"userId"`
+	Source    string    `json:"source"`
+	IssueID   uint      `json:"issueId"`
+	CommentID *uint     `json:"commentId,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (c *MentionController) GetUserMentions(ctx *gin.Context) {
+	userID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.Error(httpx.ErrInvalidID)
+		return
+	}
+
+	mentions, err := c.mentionService.GetUserMentions(uint(userID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	response := make([]MentionResponse, 0, len(mentions))
+	for _, mention := range mentions {
+		response = append(response, toMentionResponse(mention))
+	}
+	ctx.JSON(http.StatusOK, gin.H{"mentions": response})
+}
+
+func toMentionResponse(mention model.Mention) MentionResponse {
+	return MentionResponse{
+		ID:        mention.ID,
+		UserID:    mention.UserID,
+		Source:    mention.Source(),
+		IssueID:   mention.IssueID,
+		CommentID: mention.CommentID,
+		CreatedAt: mention.CreatedAt,
+	}
+}
@@ -0,0 +1,80 @@
+package presentation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/internal/httpx"
+	issueApp "issue-service-aoroa/issue/application"
+	issueInfra "issue-service-aoroa/issue/infrastructure"
+	"issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	userInfra "issue-service-aoroa/user/infrastructure"
+	userPresentation "issue-service-aoroa/user/presentation"
+
+	"github.com/gin-gonic/gin"
+)
+
+func setupTestServer() (*gin.Engine, issueApp.IssueService) {
+	gin.SetMode(gin.TestMode)
+
+	issueRepo, userRepo := issueInfra.NewIssueRepository(), userInfra.NewUserRepository()
+	service := application.NewMentionService(mentionInfra.NewMentionRepository(), issueRepo, userRepo)
+	controller := NewMentionController(service)
+
+	router := gin.New()
+	router.Use(httpx.ErrorHandler(userPresentation.ErrorMapping))
+	router.GET("/users/:id/mentions", controller.GetUserMentions)
+	admin, _ := userRepo.GetByID(1)
+	return router, issueApp.NewIssueService(issueRepo, userRepo, commentInfra.NewCommentRepository(), service).WithAudit(issueApp.AuditContext{Actor: admin})
+}
+
+func get(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder
+}
+
+func TestGetUserMentions_성공(t *testing.T) {
+	router, issues := setupTestServer()
+	issue, _ := issues.CreateIssue("로그인 오류", "@이디자인님 화면 확인 부탁드립니다", nil)
+
+	recorder := get(router, "/users/2/mentions")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var response struct {
+		Mentions []MentionResponse `json:"mentions"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	if len(response.Mentions) != 1 || response.Mentions[0].IssueID != issue.ID || response.Mentions[0].Source != "ISSUE" {
+		t.Errorf("이슈 설명의 멘션이 반환되어야 함: %s", recorder.Body.String())
+	}
+
+	if recorder := get(router, "/users/1/mentions"); !strings.Contains(recorder.Body.String(), `"mentions":[]`) {
+		t.Errorf("멘션이 없으면 빈 배열이어야 함: %s", recorder.Body.String())
+	}
+}
+
+func TestGetUserMentions_실패(t *testing.T) {
+	router, _ := setupTestServer()
+
+	tests := []struct {
+		path      string
+		status    int
+		errorCode string
+	}{
+		{"/users/999/mentions", http.StatusNotFound, "USER_NOT_FOUND"},
+		{"/users/abc/mentions", http.StatusBadRequest, "INVALID_ID"},
+	}
+	for _, tt := range tests {
+		recorder := get(router, tt.path)
+		if recorder.Code != tt.status || !strings.Contains(recorder.Body.String(), tt.errorCode) {
+			t.Errorf("%s: %d %s이어야 함. 실제: %d, %s", tt.path, tt.status, tt.errorCode, recorder.Code, recorder.Body.String())
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"issue-service-aoroa/config"
+)
+
+const migrateUsage = "사용법: migrate up|down|status"
+
+func runMigrate(cfg config.Config, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New(migrateUsage)
+	}
+	if cfg.Storage != config.StorageSQLite && cfg.Storage != config.StorageEventLog {
+		return fmt.Errorf("마이그레이션은 sqlite 또는 eventlog 저장소에서만 사용할 수 있습니다 (현재: %s)", cfg.Storage)
+	}
+
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := newMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(out, "적용할 마이그레이션이 없습니다")
+		}
+		for _, migration := range applied {
+			fmt.Fprintf(out, "적용: %04d_%s\n", migration.Version, migration.Name)
+		}
+	case "down":
+		reverted, err := migrator.Down()
+		if err != nil {
+			return err
+		}
+		if reverted == nil {
+			fmt.Fprintln(out, "되돌릴 마이그레이션이 없습니다")
+		} else {
+			fmt.Fprintf(out, "되돌림: %04d_%s\n", reverted.Version, reverted.Name)
+		}
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "대기"
+			if status.Applied {
+				state = "적용됨 " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(out, "%04d_%s\t%s\n", status.Migration.Version, status.Migration.Name, state)
+		}
+	default:
+		return errors.New(migrateUsage)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package application
+
+import (
+	"crypto/subtle"
+	"strconv"
+	"time"
+
+	"issue-service-aoroa/user/infrastructure"
+	"issue-service-aoroa/user/model"
+)
+
+// 요청의 인증 정보로 사용자를 찾는다. JWT는 sub에 사용자 ID를 담고, API 키는 설정에서 사용자 ID와 짝지어진다.
+// 비활성화된 사용자는 올바른 인증 정보를 보내도 인증하지 않는다.
+type Authenticator interface {
+	AuthenticateToken(token string) (*model.User, error)
+	AuthenticateAPIKey(key string) (*model.User, error)
+}
+
+// JWTSecret이 비어 있으면 JWT를 받지 않고, APIKeys가 비어 있으면 API 키를 받지 않는다
+type AuthConfig struct {
+	JWTSecret []byte
+	APIKeys   map[string]uint
+}
+
+type authenticator struct {
+	userRepo infrastructure.UserRepository
+	config   AuthConfig
+	now      func() time.Time
+}
+
+func NewAuthenticator(userRepo infrastructure.UserRepository, config AuthConfig) Authenticator {
+	return &authenticator{
+		userRepo: userRepo,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+func (a *authenticator) AuthenticateToken(token string) (*model.User, error) {
+	if len(a.config.JWTSecret) == 0 {
+		return nil, ErrInvalidCredentials
+	}
+	claims, err := verifyJWT(token, a.config.JWTSecret, a.now())
+	if err != nil {
+		return nil, err
+	}
+	userID, err := strconv.ParseUint(claims.Subject, 10, 32)
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+	return a.activeUser(uint(userID))
+}
+
+// 어떤 키가 일치했는지 응답 시간으로 드러나지 않도록 모든 키와 비교한다
+func (a *authenticator) AuthenticateAPIKey(key string) (*model.User, error) {
+	var userID uint
+	found := false
+	for candidate, id := range a.config.APIKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(candidate)) == 1 {
+			userID, found = id, true
+		}
+	}
+	if !found {
+		return nil, ErrInvalidCredentials
+	}
+	return a.activeUser(userID)
+}
+
+// 토큰을 발급한 뒤 지워진 사용자는 잘못된 인증 정보로 본다
+func (a *authenticator) activeUser(id uint) (*model.User, error) {
+	user, err := a.userRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrInvalidCredentials
+	}
+	if !user.Active {
+		return nil, ErrUserInactive
+	}
+	return user, nil
+}
@@ -0,0 +1,111 @@
+package application
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"issue-service-aoroa/user/infrastructure"
+)
+
+var testJWTSecret = []byte("test-jwt-secret")
+
+func signTestToken(t *testing.T, algorithm string, claims map[string]any, secret []byte) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": algorithm, "typ": "JWT"})
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("토큰 생성 실패: %v", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signJWT(signingInput, secret))
+}
+
+func newTestAuthenticator() (Authenticator, infrastructure.UserRepository) {
+	userRepo := infrastructure.NewUserRepository()
+	return NewAuthenticator(userRepo, AuthConfig{
+		JWTSecret: testJWTSecret,
+		APIKeys:   map[string]uint{"dev-key": 1, "ghost-key": 999},
+	}), userRepo
+}
+
+func TestAuthenticateToken_성공(t *testing.T) {
+	authenticator, _ := newTestAuthenticator()
+	token := signTestToken(t, "HS256", map[string]any{"sub": "2", "exp": time.Now().Add(time.Hour).Unix()}, testJWTSecret)
+
+	user, err := authenticator.AuthenticateToken(token)
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if user.ID != 2 || user.Name != "이디자인" {
+		t.Errorf("sub의 사용자가 인증되어야 함: %+v", user)
+	}
+}
+
+func TestAuthenticateToken_실패(t *testing.T) {
+	authenticator, _ := newTestAuthenticator()
+	future := time.Now().Add(time.Hour).Unix()
+	valid := signTestToken(t, "HS256", map[string]any{"sub": "1", "exp": future}, testJWTSecret)
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"형식 오류", "not-a-token", ErrInvalidCredentials},
+		{"다른 키로 서명", signTestToken(t, "HS256", map[string]any{"sub": "1", "exp": future}, []byte("other")), ErrInvalidCredentials},
+		{"서명 변조", valid[:len(valid)-2] + "AA", ErrInvalidCredentials},
+		{"none 알고리즘", signTestToken(t, "none", map[string]any{"sub": "1", "exp": future}, testJWTSecret), ErrInvalidCredentials},
+		{"만료 시각 없음", signTestToken(t, "HS256", map[string]any{"sub": "1"}, testJWTSecret), ErrInvalidCredentials},
+		{"아직 유효하지 않음", signTestToken(t, "HS256", map[string]any{"sub": "1", "exp": future, "nbf": future}, testJWTSecret), ErrInvalidCredentials},
+		{"숫자가 아닌 sub", signTestToken(t, "HS256", map[string]any{"sub": "kim", "exp": future}, testJWTSecret), ErrInvalidCredentials},
+		{"없는 사용자", signTestToken(t, "HS256", map[string]any{"sub": "999", "exp": future}, testJWTSecret), ErrInvalidCredentials},
+		{"만료됨", signTestToken(t, "HS256", map[string]any{"sub": "1", "exp": time.Now().Add(-time.Minute).Unix()}, testJWTSecret), ErrTokenExpired},
+	}
+
+	for _, tt := range tests {
+		if _, err := authenticator.AuthenticateToken(tt.token); !errors.Is(err, tt.want) {
+			t.Errorf("%s: %v여야 함. 실제: %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestAuthenticateToken_실패_서명_키가_없으면_받지_않음(t *testing.T) {
+	authenticator := NewAuthenticator(infrastructure.NewUserRepository(), AuthConfig{})
+	token := signTestToken(t, "HS256", map[string]any{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()}, nil)
+
+	if _, err := authenticator.AuthenticateToken(token); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("INVALID_CREDENTIALS여야 함. 실제: %v", err)
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	authenticator, _ := newTestAuthenticator()
+
+	user, err := authenticator.AuthenticateAPIKey("dev-key")
+	if err != nil || user.ID != 1 {
+		t.Fatalf("키에 연결된 사용자가 인증되어야 함: %+v, %v", user, err)
+	}
+	for _, key := range []string{"wrong-key", "dev-ke", "ghost-key"} {
+		if _, err := authenticator.AuthenticateAPIKey(key); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("%q: INVALID_CREDENTIALS여야 함. 실제: %v", key, err)
+		}
+	}
+}
+
+func TestAuthenticate_실패_비활성화된_사용자(t *testing.T) {
+	authenticator, userRepo := newTestAuthenticator()
+	user, _ := userRepo.GetByID(1)
+	user.Active = false
+	userRepo.Update(*user)
+
+	token := signTestToken(t, "HS256", map[string]any{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()}, testJWTSecret)
+	if _, err := authenticator.AuthenticateToken(token); !errors.Is(err, ErrUserInactive) {
+		t.Errorf("토큰: USER_INACTIVE여야 함. 실제: %v", err)
+	}
+	if _, err := authenticator.AuthenticateAPIKey("dev-key"); !errors.Is(err, ErrUserInactive) {
+		t.Errorf("API 키: USER_INACTIVE여야 함. 실제: %v", err)
+	}
+}
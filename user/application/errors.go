@@ -0,0 +1,51 @@
+package application
+
+import "issue-service-aoroa/internal/apperr"
+
+// 이슈 API의 USER_NOT_FOUND는 요청 본문이 가리키는 사용자라 400이지만, 사용자 API에서는 경로의 사용자이므로 404로 응답한다
+var (
+	ErrUserNotFound = &apperr.DomainError{
+		Code:    "USER_NOT_FOUND",
+		Message: "사용자를 찾을 수 없습니다",
+	}
+	ErrUserNameTaken = &apperr.DomainError{
+		Code:    "USER_NAME_TAKEN",
+		Message: "이미 사용 중인 이름입니다",
+	}
+	ErrUserEmailTaken = &apperr.DomainError{
+		Code:    "USER_EMAIL_TAKEN",
+		Message: "이미 사용 중인 이메일입니다",
+	}
+)
+
+// 인증에 실패한 이유는 401로 구분해 알려 주되, 없는 사용자와 잘못된 서명은 같은 에러로 본다
+var (
+	ErrAuthenticationRequired = &apperr.DomainError{
+		Code:    "AUTHENTICATION_REQUIRED",
+		Message: "인증이 필요합니다",
+	}
+	ErrInvalidCredentials = &apperr.DomainError{
+		Code:    "INVALID_CREDENTIALS",
+		Message: "인증 정보가 올바르지 않습니다",
+	}
+	ErrTokenExpired = &apperr.DomainError{
+		Code:    "TOKEN_EXPIRED",
+		Message: "인증 토큰이 만료되었습니다",
+	}
+	ErrUserInactive = &apperr.DomainError{
+		Code:    "USER_INACTIVE",
+		Message: "비활성화된 사용자입니다",
+	}
+)
+
+// 이슈 API와 같은 403 PERMISSION_DENIED 응답을 쓴다
+var (
+	ErrAdminOnly = &apperr.PermissionError{
+		Reason:  "ADMIN_ONLY",
+		Message: "관리자만 할 수 있는 작업입니다",
+	}
+	ErrSelfOrAdminOnly = &apperr.PermissionError{
+		Reason:  "SELF_OR_ADMIN_ONLY",
+		Message: "본인이나 관리자만 사용자 정보를 수정할 수 있습니다",
+	}
+)
@@ -0,0 +1,67 @@
+package application
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const jwtAlgorithm = "HS256"
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+}
+
+// exp는 필수이고 nbf는 있을 때만 확인한다
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt *int64 `json:"exp"`
+	NotBefore *int64 `json:"nbf"`
+}
+
+// HS256으로 서명된 토큰만 받는다. alg가 다르면 서명을 확인하지 않고 거부해 none 알고리즘 우회를 막는다
+func verifyJWT(token string, secret []byte, now time.Time) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, ErrInvalidCredentials
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil || header.Algorithm != jwtAlgorithm {
+		return jwtClaims{}, ErrInvalidCredentials
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, signJWT(parts[0]+"."+parts[1], secret)) {
+		return jwtClaims{}, ErrInvalidCredentials
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil || claims.Subject == "" || claims.ExpiresAt == nil {
+		return jwtClaims{}, ErrInvalidCredentials
+	}
+	if now.Unix() >= *claims.ExpiresAt {
+		return jwtClaims{}, ErrTokenExpired
+	}
+	if claims.NotBefore != nil && now.Unix() < *claims.NotBefore {
+		return jwtClaims{}, ErrInvalidCredentials
+	}
+	return claims, nil
+}
+
+func decodeJWTPart(part string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func signJWT(signingInput string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,32 @@
+package application
+
+import "issue-service-aoroa/user/model"
+
+// 사용자 등록, 역할 변경, 비활성화는 관리자만 할 수 있고, 이름과 이메일은 본인도 고칠 수 있다.
+// actor가 없으면 누가 요청했는지 알 수 없으므로 모든 변경을 거부한다.
+
+func authorizeUserAdmin(actor *model.User) error {
+	if actor == nil {
+		return ErrAuthenticationRequired
+	}
+	if actor.IsAdmin() {
+		return nil
+	}
+	return ErrAdminOnly
+}
+
+func authorizeUserUpdate(actor, user *model.User, cmd UpdateUserCommand) error {
+	if actor == nil {
+		return ErrAuthenticationRequired
+	}
+	if actor.IsAdmin() {
+		return nil
+	}
+	if cmd.Role != nil && *cmd.Role != user.Role {
+		return ErrAdminOnly
+	}
+	if actor.ID != user.ID {
+		return ErrSelfOrAdminOnly
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package application
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"issue-service-aoroa/internal/apperr"
+	issueModel "issue-service-aoroa/issue/model"
+	"issue-service-aoroa/user/infrastructure"
+	"issue-service-aoroa/user/model"
+)
+
+// 이름과 이메일은 각각 다른 사용자와 겹칠 수 없다. 이름은 @멘션에 쓰이므로 공백을 포함할 수 없다
+type UserService interface {
+	CreateUser(cmd CreateUserCommand) (*model.User, error)
+	GetUsers() ([]model.User, error)
+	GetUser(id uint) (*model.User, error)
+	UpdateUser(id uint, cmd UpdateUserCommand) (*model.User, error)
+	DeactivateUser(id uint, cmd DeactivateUserCommand) (*Deactivation, error)
+	WithActor(actor *model.User, requestID string) UserService
+}
+
+// 비활성화하는 사용자의 진행 중인 이슈를 넘긴다. 이슈 서비스가 구현하며,
+// successorID가 nil이면 담당자를 해제하고, 넘길 수 없는 이슈가 하나라도 있으면 아무것도 바꾸지 않는다.
+// HandOffAs는 actor의 역할로 권한을 검사하고 actor와 requestID를 이슈 이력에 남기는 IssueHandOff를 반환한다
+type IssueHandOff interface {
+	HandOffIssues(userID uint, successorID *uint) ([]issueModel.Issue, error)
+	HandOffAs(actor *model.User, requestID string) IssueHandOff
+}
+
+// Role이 비어 있으면 member로 만든다
+type CreateUserCommand struct {
+	Name  string
+	Email string
+	Role  model.Role
+}
+
+// nil인 필드는 바꾸지 않는다
+type UpdateUserCommand struct {
+	Name  *string
+	Email *string
+	Role  *model.Role
+}
+
+// SuccessorID가 nil이면 이슈를 넘기지 않고 담당자만 해제한다
+type DeactivateUserCommand struct {
+	SuccessorID *uint
+}
+
+// 비활성화된 사용자와, 그 사용자에게서 넘겨지거나 담당자가 해제된 이슈
+type Deactivation struct {
+	User   model.User
+	Issues []issueModel.Issue
+}
+
+type userService struct {
+	userRepo  infrastructure.UserRepository
+	handOff   IssueHandOff
+	actor     *model.User
+	requestID string
+}
+
+func NewUserService(userRepo infrastructure.UserRepository, handOff IssueHandOff) UserService {
+	return &userService{
+		userRepo: userRepo,
+		handOff:  handOff,
+	}
+}
+
+// 같은 저장소를 쓰되 actor의 역할로 권한을 검사하는 서비스를 반환한다. requestID는 넘긴 이슈의 이력에 남는다
+func (s *userService) WithActor(actor *model.User, requestID string) UserService {
+	copied := *s
+	copied.actor = actor
+	copied.requestID = requestID
+	return &copied
+}
+
+func (s *userService) CreateUser(cmd CreateUserCommand) (*model.User, error) {
+	if err := authorizeUserAdmin(s.actor); err != nil {
+		return nil, err
+	}
+
+	name, err := validateName(cmd.Name)
+	if err != nil {
+		return nil, err
+	}
+	email, err := validateEmail(cmd.Email)
+	if err != nil {
+		return nil, err
+	}
+	role := model.RoleMember
+	if cmd.Role != "" {
+		if role, err = validateRole(cmd.Role); err != nil {
+			return nil, err
+		}
+	}
+	created, err := s.userRepo.Create(model.User{Name: name, Email: email, Active: true, Role: role})
+	if err != nil {
+		return nil, duplicateError(err)
+	}
+	return &created, nil
+}
+
+func (s *userService) GetUsers() ([]model.User, error) {
+	return s.userRepo.GetAll()
+}
+
+func (s *userService) GetUser(id uint) (*model.User, error) {
+	return s.findUserByID(id)
+}
+
+func (s *userService) UpdateUser(id uint, cmd UpdateUserCommand) (*model.User, error) {
+	user, err := s.findUserByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeUserUpdate(s.actor, user, cmd); err != nil {
+		return nil, err
+	}
+
+	if cmd.Name != nil {
+		if user.Name, err = validateName(*cmd.Name); err != nil {
+			return nil, err
+		}
+	}
+	if cmd.Email != nil {
+		if user.Email, err = validateEmail(*cmd.Email); err != nil {
+			return nil, err
+		}
+	}
+	if cmd.Role != nil {
+		if user.Role, err = validateRole(*cmd.Role); err != nil {
+			return nil, err
+		}
+	}
+	return s.save(*user)
+}
+
+// 이슈 넘기기, 비활성화, 늦게 할당된 이슈 넘기기는 각각 따로 저장되며 하나의 트랜잭션으로 묶이지 않는다.
+// 대신 어느 단계에서 실패해도 같은 요청을 다시 보내 마저 처리할 수 있게 한다.
+//   - 이슈를 모두 넘긴 뒤에 비활성화하므로, 이슈를 넘기지 못하면 사용자는 활성 상태 그대로이고 되돌릴 것이 없다.
+//   - 이슈를 넘긴 뒤 비활성화하기 전에 새로 할당된 이슈는 비활성화한 뒤 한 번 더 넘긴다. 비활성화한 뒤에는 새로 할당될 수 없다.
+//   - 이미 비활성화된 사용자이면 남아 있는 이슈만 넘긴다
+func (s *userService) DeactivateUser(id uint, cmd DeactivateUserCommand) (*Deactivation, error) {
+	if err := authorizeUserAdmin(s.actor); err != nil {
+		return nil, err
+	}
+	user, err := s.findUserByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	handOff := s.handOff.HandOffAs(s.actor, s.requestID)
+	issues, err := handOff.HandOffIssues(id, cmd.SuccessorID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.Active {
+		return &Deactivation{User: *user, Issues: issues}, nil
+	}
+
+	user.Active = false
+	if user, err = s.save(*user); err != nil {
+		return nil, err
+	}
+	late, err := handOff.HandOffIssues(id, cmd.SuccessorID)
+	if err != nil {
+		return nil, err
+	}
+	return &Deactivation{User: *user, Issues: append(issues, late...)}, nil
+}
+
+func (s *userService) save(user model.User) (*model.User, error) {
+	saved, err := s.userRepo.Update(user)
+	if err != nil {
+		return nil, duplicateError(err)
+	}
+	if saved == nil {
+		return nil, ErrUserNotFound
+	}
+	return saved, nil
+}
+
+func (s *userService) findUserByID(id uint) (*model.User, error) {
+	user, err := s.userRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+func duplicateError(err error) error {
+	switch {
+	case errors.Is(err, infrastructure.ErrDuplicateName):
+		return ErrUserNameTaken
+	case errors.Is(err, infrastructure.ErrDuplicateEmail):
+		return ErrUserEmailTaken
+	}
+	return err
+}
+
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", &apperr.ValidationError{Field: "name", Message: "이름은 필수입니다"}
+	}
+	if utf8.RuneCountInString(name) > model.MaxNameLength {
+		return "", &apperr.ValidationError{Field: "name", Reason: "NAME_TOO_LONG", Message: "이름은 50자 이하여야 합니다"}
+	}
+	if strings.ContainsFunc(name, unicode.IsSpace) {
+		return "", &apperr.ValidationError{Field: "name", Reason: "NAME_HAS_SPACE", Message: "이름에는 공백을 쓸 수 없습니다"}
+	}
+	return name, nil
+}
+
+// 이메일은 대소문자를 구분하지 않으므로 소문자로 바꿔 저장한다
+func validateEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return "", &apperr.ValidationError{Field: "email", Message: "이메일은 필수입니다"}
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", &apperr.ValidationError{Field: "email", Reason: "INVALID_EMAIL", Message: "이메일 형식이 올바르지 않습니다"}
+	}
+	return email, nil
+}
+
+func validateRole(role model.Role) (model.Role, error) {
+	if !role.IsValid() {
+		return "", &apperr.ValidationError{Field: "role", Reason: "INVALID_ROLE", Message: "역할은 admin, member, reporter, viewer 중 하나여야 합니다"}
+	}
+	return role, nil
+}
@@ -0,0 +1,370 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"issue-service-aoroa/database"
+	"issue-service-aoroa/database/migrations"
+	"issue-service-aoroa/internal/apperr"
+	issueModel "issue-service-aoroa/issue/model"
+	"issue-service-aoroa/user/infrastructure"
+	"issue-service-aoroa/user/model"
+)
+
+func newSQLiteUserRepository(t *testing.T) infrastructure.UserRepository {
+	db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("SQLite 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	all, err := migrations.Load()
+	if err != nil {
+		t.Fatalf("마이그레이션 로드 실패: %v", err)
+	}
+	if _, err := migrations.NewMigrator(db, all).Up(); err != nil {
+		t.Fatalf("마이그레이션 적용 실패: %v", err)
+	}
+
+	userRepo, err := infrastructure.NewSQLiteUserRepository(db)
+	if err != nil {
+		t.Fatalf("사용자 저장소 생성 실패: %v", err)
+	}
+	return userRepo
+}
+
+// 이슈 서비스 대신 넘긴 호출과 HandOffAs로 받은 audit 정보를 기록하고, err가 있으면 그대로 실패한다.
+// 첫 호출에는 이슈 하나를 넘기고, 그 뒤의 호출에는 그사이 할당된 late를 넘긴다
+type fakeHandOff struct {
+	calls     []*uint
+	late      []issueModel.Issue
+	err       error
+	actor     *model.User
+	requestID string
+}
+
+func (f *fakeHandOff) HandOffAs(actor *model.User, requestID string) IssueHandOff {
+	f.actor = actor
+	f.requestID = requestID
+	return f
+}
+
+func (f *fakeHandOff) HandOffIssues(userID uint, successorID *uint) ([]issueModel.Issue, error) {
+	f.calls = append(f.calls, successorID)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(f.calls) > 1 {
+		return f.late, nil
+	}
+	return []issueModel.Issue{{ID: 1, Title: "넘긴 이슈"}}, nil
+}
+
+// 모든 저장소 구현체에 대해 동일한 테스트를 실행한다
+func forEachRepository(t *testing.T, test func(t *testing.T, service UserService)) {
+	forEachUserRepository(t, func(t *testing.T, userRepo infrastructure.UserRepository) {
+		test(t, newAdminService(userRepo, &fakeHandOff{}))
+	})
+}
+
+// 관리자인 1번 사용자로 요청하는 서비스
+func newAdminService(userRepo infrastructure.UserRepository, handOff IssueHandOff) UserService {
+	admin, _ := userRepo.GetByID(1)
+	return NewUserService(userRepo, handOff).WithActor(admin, "")
+}
+
+func forEachUserRepository(t *testing.T, test func(t *testing.T, userRepo infrastructure.UserRepository)) {
+	factories := []struct {
+		name string
+		new  func(t *testing.T) infrastructure.UserRepository
+	}{
+		{name: "memory", new: func(t *testing.T) infrastructure.UserRepository { return infrastructure.NewUserRepository() }},
+		{name: "sqlite", new: newSQLiteUserRepository},
+	}
+	for _, factory := range factories {
+		t.Run(factory.name, func(t *testing.T) {
+			test(t, factory.new(t))
+		})
+	}
+}
+
+func TestCreateUser_성공(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service UserService) {
+		user, err := service.CreateUser(CreateUserCommand{Name: " 최운영 ", Email: " Ops.Choi@Example.com "})
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if user.ID != 4 || user.Name != "최운영" || user.Email != "ops.choi@example.com" {
+			t.Errorf("이름은 공백을 제거하고 이메일은 소문자로 저장되어야 함: %+v", user)
+		}
+
+		found, err := service.GetUser(user.ID)
+		if err != nil || *found != *user {
+			t.Errorf("만든 사용자를 조회할 수 있어야 함: %+v, %v", found, err)
+		}
+		users, _ := service.GetUsers()
+		if len(users) != 4 {
+			t.Errorf("기본 사용자 3명과 새 사용자가 조회되어야 함: %+v", users)
+		}
+	})
+}
+
+func TestCreateUser_실패(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service UserService) {
+		tests := []struct {
+			name   string
+			cmd    CreateUserCommand
+			want   error
+			reason string
+		}{
+			{"이름 없음", CreateUserCommand{Name: " ", Email: "a@example.com"}, nil, ""},
+			{"공백이 있는 이름", CreateUserCommand{Name: "홍 길동", Email: "a@example.com"}, nil, "NAME_HAS_SPACE"},
+			{"잘못된 이메일", CreateUserCommand{Name: "최운영", Email: "홍길동 <a@example.com>"}, nil, "INVALID_EMAIL"},
+			{"중복 이름", CreateUserCommand{Name: "김개발", Email: "a@example.com"}, ErrUserNameTaken, ""},
+			{"대소문자만 다른 중복 이메일", CreateUserCommand{Name: "최운영", Email: "DEV.KIM@example.com"}, ErrUserEmailTaken, ""},
+		}
+
+		for _, tt := range tests {
+			_, err := service.CreateUser(tt.cmd)
+			if tt.want != nil {
+				if !errors.Is(err, tt.want) {
+					t.Errorf("%s: %v여야 함. 실제: %v", tt.name, tt.want, err)
+				}
+				continue
+			}
+			var validationErr *apperr.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Reason != tt.reason {
+				t.Errorf("%s: 검증 에러(%s)여야 함. 실제: %v", tt.name, tt.reason, err)
+			}
+		}
+	})
+}
+
+// go test -race 로 실행해 데이터 경합이 없는지 확인한다
+func TestCreateUser_동시에_같은_이름과_이메일로_만들면_하나만_성공(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service UserService) {
+		const workers = 16
+
+		var (
+			wg        sync.WaitGroup
+			succeeded atomic.Int32
+		)
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := service.CreateUser(CreateUserCommand{Name: "최운영", Email: fmt.Sprintf("ops%d@example.com", w)})
+				if err == nil {
+					succeeded.Add(1)
+				} else if !errors.Is(err, ErrUserNameTaken) {
+					t.Errorf("USER_NAME_TAKEN이어야 함. 실제: %v", err)
+				}
+
+				_, err = service.CreateUser(CreateUserCommand{Name: fmt.Sprintf("운영%d", w), Email: "ops@example.com"})
+				if err == nil {
+					succeeded.Add(1)
+				} else if !errors.Is(err, ErrUserEmailTaken) {
+					t.Errorf("USER_EMAIL_TAKEN이어야 함. 실제: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if succeeded.Load() != 2 {
+			t.Errorf("이름과 이메일이 겹치는 사용자는 각각 하나만 만들어져야 함. 실제: %d", succeeded.Load())
+		}
+		if users, _ := service.GetUsers(); len(users) != 5 {
+			t.Errorf("기본 사용자 3명과 새 사용자 2명이어야 함. 실제: %d", len(users))
+		}
+	})
+}
+
+func TestGetByEmail_소문자로_정규화된_이메일로_조회(t *testing.T) {
+	forEachUserRepository(t, func(t *testing.T, userRepo infrastructure.UserRepository) {
+		created, err := newAdminService(userRepo, &fakeHandOff{}).CreateUser(CreateUserCommand{Name: "최운영", Email: "Ops.Choi@Example.com"})
+		if err != nil {
+			t.Fatalf("사용자 생성 실패: %v", err)
+		}
+		userRepo.Create(model.User{Name: "이메일없음", Active: true, Role: model.RoleMember})
+
+		found, err := userRepo.GetByEmail("ops.choi@example.com")
+		if err != nil || found == nil || found.ID != created.ID {
+			t.Errorf("이메일로 사용자를 찾아야 함: %+v, %v", found, err)
+		}
+		for _, email := range []string{"none@example.com", ""} {
+			if missing, err := userRepo.GetByEmail(email); err != nil || missing != nil {
+				t.Errorf("%q: 없는 이메일은 nil이어야 함: %+v, %v", email, missing, err)
+			}
+		}
+	})
+}
+
+func TestGetByNames_이름이_일치하는_사용자만_ID순으로_조회(t *testing.T) {
+	forEachUserRepository(t, func(t *testing.T, userRepo infrastructure.UserRepository) {
+		users, err := userRepo.GetByNames([]string{"박기획", "없는이름", "김개발", "김개"})
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if len(users) != 2 || users[0].Name != "김개발" || users[1].Name != "박기획" {
+			t.Errorf("이름이 정확히 일치하는 사용자만 ID순으로 반환되어야 함: %+v", users)
+		}
+		if none, err := userRepo.GetByNames(nil); err != nil || len(none) != 0 {
+			t.Errorf("이름이 없으면 빈 목록이어야 함: %+v, %v", none, err)
+		}
+	})
+}
+
+func TestUpdateUser(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service UserService) {
+		email := "kim.dev@example.com"
+		updated, err := service.UpdateUser(1, UpdateUserCommand{Email: &email})
+		if err != nil {
+			t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+		}
+		if updated.Name != "김개발" || updated.Email != email {
+			t.Errorf("지정한 필드만 바뀌어야 함: %+v", updated)
+		}
+
+		sameName := "김개발"
+		if _, err := service.UpdateUser(1, UpdateUserCommand{Name: &sameName}); err != nil {
+			t.Errorf("자기 이름으로는 바꿀 수 있어야 함: %v", err)
+		}
+		takenName := "이디자인"
+		if _, err := service.UpdateUser(1, UpdateUserCommand{Name: &takenName}); !errors.Is(err, ErrUserNameTaken) {
+			t.Errorf("다른 사용자의 이름은 쓸 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := service.UpdateUser(999, UpdateUserCommand{Name: &sameName}); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("없는 사용자는 USER_NOT_FOUND여야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestDeactivateUser_성공(t *testing.T) {
+	userRepo := infrastructure.NewUserRepository()
+	handOff := &fakeHandOff{late: []issueModel.Issue{{ID: 2, Title: "비활성화 직전에 할당된 이슈"}}}
+	admin, _ := userRepo.GetByID(1)
+	service := NewUserService(userRepo, handOff).WithActor(admin, "req-1")
+
+	successorID := uint(2)
+	deactivation, err := service.DeactivateUser(1, DeactivateUserCommand{SuccessorID: &successorID})
+	if err != nil {
+		t.Fatalf("에러가 발생하지 않아야 함: %v", err)
+	}
+	if deactivation.User.Active || len(deactivation.Issues) != 2 {
+		t.Errorf("비활성화된 사용자와, 비활성화 직전에 할당된 이슈까지 넘긴 이슈가 반환되어야 함: %+v", deactivation)
+	}
+	if len(handOff.calls) != 2 || *handOff.calls[0] != 2 || *handOff.calls[1] != 2 {
+		t.Errorf("비활성화 전후로 후임자에게 이슈를 넘겨야 함: %+v", handOff.calls)
+	}
+	if handOff.actor == nil || handOff.actor.ID != 1 || handOff.requestID != "req-1" {
+		t.Errorf("비활성화한 사용자와 요청 ID로 이슈를 넘겨야 함: %+v, %q", handOff.actor, handOff.requestID)
+	}
+	if user, _ := userRepo.GetByID(1); user.Active {
+		t.Error("저장된 사용자도 비활성화되어야 함")
+	}
+
+	// 이미 비활성화된 사용자는 남은 이슈만 다시 넘긴다
+	if _, err := service.DeactivateUser(1, DeactivateUserCommand{}); err != nil {
+		t.Fatalf("다시 실행해도 에러가 발생하지 않아야 함: %v", err)
+	}
+	if len(handOff.calls) != 3 || handOff.calls[2] != nil {
+		t.Errorf("다시 실행하면 이슈를 한 번 다시 넘겨야 함: %+v", handOff.calls)
+	}
+}
+
+func TestDeactivateUser_실패_이슈를_넘기지_못하면_활성_상태_유지(t *testing.T) {
+	forEachUserRepository(t, func(t *testing.T, userRepo infrastructure.UserRepository) {
+		handOffErr := errors.New("이슈 저장 실패")
+		handOff := &fakeHandOff{err: handOffErr}
+		service := newAdminService(userRepo, handOff)
+
+		if _, err := service.DeactivateUser(1, DeactivateUserCommand{}); !errors.Is(err, handOffErr) {
+			t.Fatalf("이슈를 넘기지 못한 에러가 반환되어야 함. 실제: %v", err)
+		}
+		if user, _ := userRepo.GetByID(1); !user.Active {
+			t.Error("이슈를 넘기지 못하면 사용자는 활성 상태여야 함")
+		}
+		if len(handOff.calls) != 1 {
+			t.Errorf("이슈를 넘기지 못하면 더 진행하지 않아야 함: %+v", handOff.calls)
+		}
+	})
+}
+
+func TestDeactivateUser_실패_없는_사용자(t *testing.T) {
+	handOff := &fakeHandOff{}
+	service := newAdminService(infrastructure.NewUserRepository(), handOff)
+
+	if _, err := service.DeactivateUser(999, DeactivateUserCommand{}); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("USER_NOT_FOUND여야 함. 실제: %v", err)
+	}
+	if len(handOff.calls) != 0 {
+		t.Error("없는 사용자의 이슈는 넘기지 않아야 함")
+	}
+}
+
+func TestCreateUser_역할(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service UserService) {
+		member, err := service.CreateUser(CreateUserCommand{Name: "최운영", Email: "ops.choi@example.com"})
+		if err != nil || member.Role != model.RoleMember {
+			t.Fatalf("역할을 생략하면 member여야 함: %+v, %v", member, err)
+		}
+		viewer, err := service.CreateUser(CreateUserCommand{Name: "최열람", Email: "view.choi@example.com", Role: model.RoleViewer})
+		if err != nil || viewer.Role != model.RoleViewer {
+			t.Fatalf("지정한 역할로 만들어야 함: %+v, %v", viewer, err)
+		}
+		if saved, _ := service.GetUser(viewer.ID); saved.Role != model.RoleViewer {
+			t.Errorf("역할이 저장되어야 함: %+v", saved)
+		}
+
+		var validationErr *apperr.ValidationError
+		_, err = service.CreateUser(CreateUserCommand{Name: "최손님", Email: "guest.choi@example.com", Role: "guest"})
+		if !errors.As(err, &validationErr) || validationErr.Reason != "INVALID_ROLE" {
+			t.Errorf("INVALID_ROLE이어야 함. 실제: %v", err)
+		}
+	})
+}
+
+func TestUserService_권한(t *testing.T) {
+	forEachRepository(t, func(t *testing.T, service UserService) {
+		admin, _ := service.GetUser(1)
+		member, _ := service.GetUser(2)
+		asMember := service.WithActor(member, "")
+
+		if _, err := asMember.CreateUser(CreateUserCommand{Name: "최운영", Email: "ops.choi@example.com"}); !errors.Is(err, ErrAdminOnly) {
+			t.Errorf("member는 사용자를 등록할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := asMember.DeactivateUser(3, DeactivateUserCommand{}); !errors.Is(err, ErrAdminOnly) {
+			t.Errorf("member는 사용자를 비활성화할 수 없어야 함. 실제: %v", err)
+		}
+
+		name := "이브랜드"
+		if _, err := asMember.UpdateUser(2, UpdateUserCommand{Name: &name}); err != nil {
+			t.Errorf("본인의 이름은 고칠 수 있어야 함: %v", err)
+		}
+		if _, err := asMember.UpdateUser(3, UpdateUserCommand{Name: &name}); !errors.Is(err, ErrSelfOrAdminOnly) {
+			t.Errorf("다른 사용자는 고칠 수 없어야 함. 실제: %v", err)
+		}
+		role := model.RoleAdmin
+		if _, err := asMember.UpdateUser(2, UpdateUserCommand{Role: &role}); !errors.Is(err, ErrAdminOnly) {
+			t.Errorf("본인의 역할은 바꿀 수 없어야 함. 실제: %v", err)
+		}
+
+		anonymous := service.WithActor(nil, "")
+		if _, err := anonymous.CreateUser(CreateUserCommand{Name: "최운영", Email: "ops.choi@example.com"}); !errors.Is(err, ErrAuthenticationRequired) {
+			t.Errorf("요청한 사용자가 없으면 등록할 수 없어야 함. 실제: %v", err)
+		}
+		if _, err := anonymous.UpdateUser(2, UpdateUserCommand{Name: &name}); !errors.Is(err, ErrAuthenticationRequired) {
+			t.Errorf("요청한 사용자가 없으면 고칠 수 없어야 함. 실제: %v", err)
+		}
+
+		promoted, err := service.WithActor(admin, "").UpdateUser(2, UpdateUserCommand{Role: &role})
+		if err != nil || promoted.Role != model.RoleAdmin {
+			t.Errorf("관리자는 역할을 바꿀 수 있어야 함: %+v, %v", promoted, err)
+		}
+	})
+}
@@ -1,35 +1,139 @@
 package infrastructure
 
-import userModel "issue-service-aoroa/user/model"
+import (
+	"errors"
+	"sync"
 
+	userModel "issue-service-aoroa/user/model"
+)
+
+// Create와 Update는 이름이나 이메일이 다른 사용자와 겹치면 저장하지 않고 반환한다
+var (
+	ErrDuplicateName  = errors.New("같은 이름의 사용자가 이미 있습니다")
+	ErrDuplicateEmail = errors.New("같은 이메일의 사용자가 이미 있습니다")
+)
+
+// 이름과 이메일의 중복은 저장소가 저장하는 시점에 검사하므로 동시에 같은 값으로 저장해도 하나만 성공한다.
+// 이메일은 Create, Update, GetByEmail 모두 소문자로 정규화된 값을 받는다
 type UserRepository interface {
+	Create(user userModel.User) (userModel.User, error)
 	GetByID(id uint) (*userModel.User, error)
-	GetAll() []userModel.User
+	GetByEmail(email string) (*userModel.User, error)
+	GetByNames(names []string) ([]userModel.User, error)
+	GetAll() ([]userModel.User, error)
+	Update(user userModel.User) (*userModel.User, error)
 }
 
 type userRepository struct {
-	users []userModel.User
+	mu     sync.RWMutex
+	users  []userModel.User
+	lastID uint
 }
 
 func NewUserRepository() UserRepository {
+	users := defaultUsers()
 	return &userRepository{
-		users: []userModel.User{
-			{ID: 1, Name: "김개발"},
-			{ID: 2, Name: "이디자인"},
-			{ID: 3, Name: "박기획"},
-		},
+		users:  users,
+		lastID: users[len(users)-1].ID,
+	}
+}
+
+func defaultUsers() []userModel.User {
+	return []userModel.User{
+		{ID: 1, Name: "김개발", Email: "dev.kim@example.com", Active: true, Role: userModel.RoleAdmin},
+		{ID: 2, Name: "이디자인", Email: "design.lee@example.com", Active: true, Role: userModel.RoleMember},
+		{ID: 3, Name: "박기획", Email: "plan.park@example.com", Active: true, Role: userModel.RoleReporter},
 	}
 }
 
+func (r *userRepository) Create(user userModel.User) (userModel.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkUnique(user); err != nil {
+		return userModel.User{}, err
+	}
+	r.lastID++
+	user.ID = r.lastID
+	r.users = append(r.users, user)
+	return user, nil
+}
+
 func (r *userRepository) GetByID(id uint) (*userModel.User, error) {
+	return r.find(func(user userModel.User) bool { return user.ID == id })
+}
+
+// 이메일이 없는 사용자는 빈 문자열로 찾을 수 없다
+func (r *userRepository) GetByEmail(email string) (*userModel.User, error) {
+	return r.find(func(user userModel.User) bool { return user.Email != "" && user.Email == email })
+}
+
+// 이름이 names 중 하나인 사용자를 ID순으로 반환한다. 없는 이름은 무시한다
+func (r *userRepository) GetByNames(names []string) ([]userModel.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	users := []userModel.User{}
 	for _, user := range r.users {
-		if user.ID == id {
-			return &user, nil
+		if wanted[user.Name] {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (r *userRepository) GetAll() ([]userModel.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]userModel.User, len(r.users))
+	copy(users, r.users)
+	return users, nil
+}
+
+func (r *userRepository) Update(updated userModel.User) (*userModel.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, user := range r.users {
+		if user.ID == updated.ID {
+			if err := r.checkUnique(updated); err != nil {
+				return nil, err
+			}
+			r.users[i] = updated
+			return &updated, nil
 		}
 	}
 	return nil, nil
 }
 
-func (r *userRepository) GetAll() []userModel.User {
-	return r.users
-}
\ No newline at end of file
+// 잠금을 잡은 채로 호출해야 한다. 같은 ID의 사용자는 자기 자신이므로 검사하지 않는다
+func (r *userRepository) checkUnique(user userModel.User) error {
+	for _, other := range r.users {
+		if other.ID != user.ID && other.Name == user.Name {
+			return ErrDuplicateName
+		}
+	}
+	for _, other := range r.users {
+		if other.ID != user.ID && user.Email != "" && other.Email == user.Email {
+			return ErrDuplicateEmail
+		}
+	}
+	return nil
+}
+
+func (r *userRepository) find(match func(user userModel.User) bool) (*userModel.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, user := range r.users {
+		if match(user) {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,164 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"issue-service-aoroa/database"
+	userModel "issue-service-aoroa/user/model"
+)
+
+const selectUsers = `SELECT id, name, email, active, role FROM users`
+
+type sqliteUserRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteUserRepository(db *sql.DB) (UserRepository, error) {
+	repo := &sqliteUserRepository{db: db}
+	if err := repo.seedDefaultUsers(); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (r *sqliteUserRepository) Create(user userModel.User) (userModel.User, error) {
+	result, err := r.db.Exec(`INSERT INTO users (name, email, active, role) VALUES (?, ?, ?, ?)`,
+		user.Name, nullableEmail(user.Email), user.Active, user.Role,
+	)
+	if err != nil {
+		return userModel.User{}, duplicateError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return userModel.User{}, err
+	}
+	user.ID = uint(id)
+	return user, nil
+}
+
+func (r *sqliteUserRepository) GetByID(id uint) (*userModel.User, error) {
+	return r.queryOne(selectUsers+` WHERE id = ?`, id)
+}
+
+// 이메일이 없는 사용자는 NULL로 저장되므로 빈 문자열로는 찾을 수 없다
+func (r *sqliteUserRepository) GetByEmail(email string) (*userModel.User, error) {
+	return r.queryOne(selectUsers+` WHERE email = ?`, email)
+}
+
+// 이름 수가 바인딩 변수 한도를 넘을 수 있으므로 이름 목록을 JSON 배열 하나로 넘긴다
+func (r *sqliteUserRepository) GetByNames(names []string) ([]userModel.User, error) {
+	if len(names) == 0 {
+		return []userModel.User{}, nil
+	}
+	encoded, err := json.Marshal(names)
+	if err != nil {
+		return nil, err
+	}
+	return r.queryAll(selectUsers+` WHERE name IN (SELECT value FROM json_each(?)) ORDER BY id`, string(encoded))
+}
+
+func (r *sqliteUserRepository) GetAll() ([]userModel.User, error) {
+	return r.queryAll(selectUsers + ` ORDER BY id`)
+}
+
+func (r *sqliteUserRepository) Update(user userModel.User) (*userModel.User, error) {
+	result, err := r.db.Exec(`UPDATE users SET name = ?, email = ?, active = ?, role = ? WHERE id = ?`,
+		user.Name, nullableEmail(user.Email), user.Active, user.Role, user.ID,
+	)
+	if err != nil {
+		return nil, duplicateError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+func (r *sqliteUserRepository) queryAll(query string, args ...any) ([]userModel.User, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []userModel.User{}
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+func (r *sqliteUserRepository) queryOne(query string, args ...any) (*userModel.User, error) {
+	user, err := scanUser(r.db.QueryRow(query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *sqliteUserRepository) seedDefaultUsers() error {
+	var count int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	for _, user := range defaultUsers() {
+		if _, err := r.db.Exec(`INSERT INTO users (id, name, email, active, role) VALUES (?, ?, ?, ?, ?)`,
+			user.ID, user.Name, user.Email, user.Active, user.Role,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row scanner) (userModel.User, error) {
+	var (
+		user  userModel.User
+		email sql.NullString
+	)
+	if err := row.Scan(&user.ID, &user.Name, &email, &user.Active, &user.Role); err != nil {
+		return userModel.User{}, err
+	}
+	user.Email = email.String
+	return user, nil
+}
+
+// 유일 인덱스 위반을 메모리 저장소와 같은 에러로 바꾼다
+func duplicateError(err error) error {
+	switch {
+	case database.IsUniqueViolation(err, "users.name"):
+		return ErrDuplicateName
+	case database.IsUniqueViolation(err, "users.email"):
+		return ErrDuplicateEmail
+	}
+	return err
+}
+
+// 이메일이 없는 사용자가 여럿이어도 유일 인덱스에 걸리지 않도록 빈 값은 NULL로 저장한다
+func nullableEmail(email string) *string {
+	if email == "" {
+		return nil
+	}
+	return &email
+}
@@ -0,0 +1,19 @@
+package model
+
+// 역할별로 할 수 있는 이슈 작업은 이슈 서비스의 권한 정책이 정한다
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleMember   Role = "member"
+	RoleReporter Role = "reporter"
+	RoleViewer   Role = "viewer"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleMember, RoleReporter, RoleViewer:
+		return true
+	}
+	return false
+}
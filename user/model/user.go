@@ -1,6 +1,20 @@
 package model
 
+// 이름은 @멘션으로 찾으므로 멘션에서 이름 후보를 고를 때도 이 길이까지만 본다
+const MaxNameLength = 50
+
+// Email은 소문자로 정규화해 저장한다. 이메일이 도입되기 전에 만들어진 사용자는 비어 있을 수 있다.
+// 비활성화된 사용자에게는 이슈를 할당할 수 없다. 이슈와 댓글에 담긴 사용자 정보는 그 시점의 사본일 수 있으므로
+// 이메일, 활성 여부와 역할은 JSON에 담지 않고 사용자 API에서만 보여 준다.
+// 이슈, 변경 이력, 댓글을 조회할 수 있는 모든 사용자에게 이메일이 드러나지 않게 하기 위해서다
 type User struct {
-	ID   uint   `json:"id"`
-	Name string `json:"name"`
-}
\ No newline at end of file
+	ID     uint   `json:"id"`
+	Name   string `json:"name"`
+	Email  string `json:"-"`
+	Active bool   `json:"-"`
+	Role   Role   `json:"-"`
+}
+
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
@@ -0,0 +1,72 @@
+package presentation
+
+import (
+	"errors"
+	"net/http"
+
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/user/application"
+)
+
+// 사용자 API의 에러를 HTTP 상태 코드와 에러 응답으로 바꾸는 규칙. httpx.ErrorHandler에 넘겨 쓴다
+var ErrorMapping = httpx.ErrorMapping{
+	Status:   statusOf,
+	Messages: errorMessages,
+}
+
+// 인증 에러는 인증 미들웨어와 함께 httpx가 처리한다
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, application.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrUserNameTaken),
+		errors.Is(err, application.ErrUserEmailTaken):
+		return http.StatusConflict
+	default:
+		return 0
+	}
+}
+
+var errorMessages = i18n.Catalog{
+	"USER_NOT_FOUND": {
+		i18n.Korean:  "사용자를 찾을 수 없습니다",
+		i18n.English: "User not found",
+	},
+	"VALIDATION_FAILED.name": {
+		i18n.Korean:  "이름은 필수입니다",
+		i18n.English: "A name is required",
+	},
+	"VALIDATION_FAILED.NAME_TOO_LONG": {
+		i18n.Korean:  "이름은 50자 이하여야 합니다",
+		i18n.English: "A name must be at most 50 characters",
+	},
+	"VALIDATION_FAILED.NAME_HAS_SPACE": {
+		i18n.Korean:  "이름에는 공백을 쓸 수 없습니다",
+		i18n.English: "A name cannot contain spaces",
+	},
+	"VALIDATION_FAILED.email": {
+		i18n.Korean:  "이메일은 필수입니다",
+		i18n.English: "An email is required",
+	},
+	"VALIDATION_FAILED.INVALID_EMAIL": {
+		i18n.Korean:  "이메일 형식이 올바르지 않습니다",
+		i18n.English: "The email address is invalid",
+	},
+	"VALIDATION_FAILED.INVALID_ROLE": {
+		i18n.Korean:  "역할은 admin, member, reporter, viewer 중 하나여야 합니다",
+		i18n.English: "Role must be one of admin, member, reporter or viewer",
+	},
+	"USER_NAME_TAKEN": {
+		i18n.Korean:  "이미 사용 중인 이름입니다",
+		i18n.English: "The name is already taken",
+	},
+	"USER_EMAIL_TAKEN": {
+		i18n.Korean:  "이미 사용 중인 이메일입니다",
+		i18n.English: "The email is already taken",
+	},
+	"PERMISSION_DENIED.SELF_OR_ADMIN_ONLY": {
+		i18n.Korean:  "본인이나 관리자만 사용자 정보를 수정할 수 있습니다",
+		i18n.English: "Only the user or an administrator can modify the user",
+	},
+}
@@ -0,0 +1,42 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	"issue-service-aoroa/i18n"
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/user/application"
+)
+
+func TestErrorMapping_사용자_에러_상태코드_매핑(t *testing.T) {
+	tests := []struct {
+		err       error
+		status    int
+		errorCode string
+	}{
+		{application.ErrUserNotFound, http.StatusNotFound, "USER_NOT_FOUND"},
+		{application.ErrUserNameTaken, http.StatusConflict, "USER_NAME_TAKEN"},
+		{application.ErrUserEmailTaken, http.StatusConflict, "USER_EMAIL_TAKEN"},
+		{application.ErrSelfOrAdminOnly, http.StatusForbidden, "PERMISSION_DENIED"},
+	}
+
+	for _, tt := range tests {
+		response := httpx.NewErrorResponse(tt.err, i18n.English, ErrorMapping)
+
+		if response.Code != tt.status || response.ErrorCode != tt.errorCode {
+			t.Errorf("%v: %d %s이어야 함. 실제: %d %s", tt.err, tt.status, tt.errorCode, response.Code, response.ErrorCode)
+		}
+	}
+}
+
+func TestErrorMapping_모든_사용자_에러_코드에_영어_메시지_존재(t *testing.T) {
+	for code, messages := range errorMessages {
+		if _, ok := messages[i18n.English]; !ok {
+			t.Errorf("%s에 영어 메시지가 없음", code)
+		}
+		if _, ok := messages[i18n.Korean]; !ok {
+			t.Errorf("%s에 한국어 메시지가 없음", code)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package presentation
+
+import (
+	"net/http"
+	"strconv"
+
+	"issue-service-aoroa/internal/httpx"
+	"issue-service-aoroa/user/application"
+	"issue-service-aoroa/user/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 에러 응답은 httpx.ErrorHandler가 만든다
+type UserController struct {
+	userService application.UserService
+}
+
+func NewUserController(userService application.UserService) *UserController {
+	return &UserController{userService: userService}
+}
+
+// role을 생략하면 member로 만든다
+type CreateUserRequest struct {
+	Name  string     `json:"name"`
+	Email string     `json:"email"`
+	Role  model.Role `json:"role"`
+}
+
+// 생략한 필드는 바꾸지 않는다
+type UpdateUserRequest struct {
+	Name  *string     `json:"name"`
+	Email *string     `json:"email"`
+	Role  *model.Role `json:"role"`
+}
+
+// 본문을 생략하거나 successorId가 없으면 담당자만 해제한다
+type DeactivateUserRequest struct {
+	SuccessorID *uint `json:"successorId"`
+}
+
+// email은 관리자나 본인이 조회할 때만 담는다
+type UserResponse struct {
+	ID     uint       `json:"id"`
+	Name   string     `json:"name"`
+	Email  string     `json:"email,omitempty"`
+	Role   model.Role `json:"role"`
+	Active bool       `json:"active"`
+}
+
+// issueIds는 후임자에게 넘겨졌거나 담당자가 해제된 이슈들이다
+type DeactivationResponse struct {
+	User     UserResponse `json:"user"`
+	IssueIDs []uint       `json:"issueIds"`
+}
+
+func (c *UserController) CreateUser(ctx *gin.Context) {
+	var req CreateUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(httpx.ErrInvalidRequest)
+		return
+	}
+
+	user, err := c.actingService(ctx).CreateUser(application.CreateUserCommand{Name: req.Name, Email: req.Email, Role: req.Role})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toUserResponse(ctx, *user))
+}
+
+func (c *UserController) GetUsers(ctx *gin.Context) {
+	users, err := c.userService.GetUsers()
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	response := make([]UserResponse, 0, len(users))
+	for _, user := range users {
+		response = append(response, toUserResponse(ctx, user))
+	}
+	ctx.JSON(http.StatusOK, gin.H{"users": response})
+}
+
+func (c *UserController) GetUserByID(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	user, err := c.userService.GetUser(id)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toUserResponse(ctx, *user))
+}
+
+func (c *UserController) UpdateUser(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var req UpdateUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(httpx.ErrInvalidRequest)
+		return
+	}
+
+	user, err := c.actingService(ctx).UpdateUser(id, application.UpdateUserCommand{Name: req.Name, Email: req.Email, Role: req.Role})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toUserResponse(ctx, *user))
+}
+
+func (c *UserController) DeactivateUser(ctx *gin.Context) {
+	id, err := parseID(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var req DeactivateUserRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.Error(httpx.ErrInvalidRequest)
+			return
+		}
+	}
+
+	deactivation, err := c.actingService(ctx).DeactivateUser(id, application.DeactivateUserCommand{SuccessorID: req.SuccessorID})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	issueIDs := make([]uint, 0, len(deactivation.Issues))
+	for _, issue := range deactivation.Issues {
+		issueIDs = append(issueIDs, issue.ID)
+	}
+	ctx.JSON(http.StatusOK, DeactivationResponse{User: toUserResponse(ctx, deactivation.User), IssueIDs: issueIDs})
+}
+
+func toUserResponse(ctx *gin.Context, user model.User) UserResponse {
+	response := UserResponse{
+		ID:     user.ID,
+		Name:   user.Name,
+		Role:   user.Role,
+		Active: user.Active,
+	}
+	if canSeeEmail(httpx.AuthenticatedUser(ctx), user) {
+		response.Email = user.Email
+	}
+	return response
+}
+
+func canSeeEmail(viewer *model.User, user model.User) bool {
+	return viewer != nil && (viewer.IsAdmin() || viewer.ID == user.ID)
+}
+
+// 사용자를 바꾸는 요청은 인증된 사용자의 역할로 권한을 검사한다
+func (c *UserController) actingService(ctx *gin.Context) application.UserService {
+	return c.userService.WithActor(httpx.AuthenticatedUser(ctx), httpx.RequestIDOf(ctx))
+}
+
+func parseID(ctx *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return 0, httpx.ErrInvalidID
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,213 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	commentInfra "issue-service-aoroa/comment/infrastructure"
+	"issue-service-aoroa/internal/httpx"
+	issueApp "issue-service-aoroa/issue/application"
+	issueInfra "issue-service-aoroa/issue/infrastructure"
+	issuePresentation "issue-service-aoroa/issue/presentation"
+	mentionApp "issue-service-aoroa/mention/application"
+	mentionInfra "issue-service-aoroa/mention/infrastructure"
+	"issue-service-aoroa/user/application"
+	"issue-service-aoroa/user/infrastructure"
+
+	"github.com/gin-gonic/gin"
+)
+
+func setupTestServer() *gin.Engine {
+	router, _ := setupTestServerWithIssues()
+	return router
+}
+
+// 비활성화할 때 이슈를 넘기므로 같은 저장소를 쓰는 이슈 서비스도 함께 돌려준다.
+// 사용자 n은 API 키 "key-n"으로 인증한다
+func setupTestServerWithIssues() (*gin.Engine, issueApp.IssueService) {
+	gin.SetMode(gin.TestMode)
+
+	userRepo := infrastructure.NewUserRepository()
+	issueRepo := issueInfra.NewIssueRepository()
+	mentions := mentionApp.NewMentionService(mentionInfra.NewMentionRepository(), issueRepo, userRepo)
+	issueService := issueApp.NewIssueService(issueRepo, userRepo, commentInfra.NewCommentRepository(), mentions)
+	controller := NewUserController(application.NewUserService(userRepo, issueService))
+	authenticator := application.NewAuthenticator(userRepo, application.AuthConfig{
+		APIKeys: map[string]uint{"key-1": 1, "key-2": 2, "key-3": 3},
+	})
+
+	router := gin.New()
+	router.Use(httpx.RequestID())
+	router.Use(httpx.ErrorHandler(ErrorMapping, issuePresentation.ErrorMapping))
+	router.Use(httpx.Authenticate(authenticator))
+	router.POST("/users", controller.CreateUser)
+	router.GET("/users", controller.GetUsers)
+	router.GET("/users/:id", controller.GetUserByID)
+	router.PATCH("/users/:id", controller.UpdateUser)
+	router.POST("/users/:id/deactivate", controller.DeactivateUser)
+	admin, _ := userRepo.GetByID(1)
+	return router, issueService.WithAudit(issueApp.AuditContext{Actor: admin})
+}
+
+// 관리자인 1번 사용자로 요청한다
+func do(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	return doAs(router, 1, method, path, body)
+}
+
+func doAs(router *gin.Engine, userID uint, method, path, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	request.Header.Set(httpx.APIKeyHeader, fmt.Sprintf("key-%d", userID))
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestUserAPI_생성_조회_수정(t *testing.T) {
+	router := setupTestServer()
+
+	recorder := do(router, http.MethodPost, "/users", `{"name": "최운영", "email": "ops.choi@example.com"}`)
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("201이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var created UserResponse
+	json.Unmarshal(recorder.Body.Bytes(), &created)
+	if created.ID != 4 || created.Email != "ops.choi@example.com" {
+		t.Errorf("만든 사용자가 반환되어야 함: %s", recorder.Body.String())
+	}
+
+	if !strings.Contains(recorder.Body.String(), `"role":"member"`) {
+		t.Errorf("역할을 생략하면 member여야 함: %s", recorder.Body.String())
+	}
+
+	recorder = do(router, http.MethodPatch, "/users/4", `{"name": "최인프라", "role": "viewer"}`)
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"name":"최인프라"`) || !strings.Contains(recorder.Body.String(), `"role":"viewer"`) {
+		t.Errorf("이름과 역할이 바뀌어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = do(router, http.MethodGet, "/users", "")
+	var response struct {
+		Users []UserResponse `json:"users"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	if recorder.Code != http.StatusOK || len(response.Users) != 4 || response.Users[3].Name != "최인프라" {
+		t.Errorf("전체 사용자가 반환되어야 함: %s", recorder.Body.String())
+	}
+}
+
+func TestUserAPI_이메일은_관리자와_본인에게만_보임(t *testing.T) {
+	router := setupTestServer()
+
+	recorder := doAs(router, 2, http.MethodGet, "/users", "")
+	var response struct {
+		Users []UserResponse `json:"users"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	if recorder.Code != http.StatusOK || len(response.Users) != 3 {
+		t.Fatalf("전체 사용자가 반환되어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	for _, user := range response.Users {
+		if self := user.ID == 2; (user.Email != "") != self {
+			t.Errorf("member에게는 자기 이메일만 보여야 함: %+v", user)
+		}
+	}
+
+	if recorder = doAs(router, 2, http.MethodGet, "/users/1", ""); strings.Contains(recorder.Body.String(), `"email"`) {
+		t.Errorf("다른 사용자의 이메일은 보이지 않아야 함: %s", recorder.Body.String())
+	}
+	if recorder = doAs(router, 3, http.MethodGet, "/users/3", ""); !strings.Contains(recorder.Body.String(), `"email":"plan.park@example.com"`) {
+		t.Errorf("본인의 이메일은 보여야 함: %s", recorder.Body.String())
+	}
+	if recorder = do(router, http.MethodGet, "/users/2", ""); !strings.Contains(recorder.Body.String(), `"email":"design.lee@example.com"`) {
+		t.Errorf("관리자에게는 이메일이 보여야 함: %s", recorder.Body.String())
+	}
+}
+
+func TestUserAPI_실패(t *testing.T) {
+	router := setupTestServer()
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		body      string
+		status    int
+		errorCode string
+	}{
+		{"잘못된 JSON", http.MethodPost, "/users", `{"name": `, http.StatusBadRequest, "INVALID_REQUEST"},
+		{"이메일 없음", http.MethodPost, "/users", `{"name": "최운영"}`, http.StatusBadRequest, "VALIDATION_FAILED"},
+		{"중복 이메일", http.MethodPost, "/users", `{"name": "최운영", "email": "dev.kim@example.com"}`, http.StatusConflict, "USER_EMAIL_TAKEN"},
+		{"중복 이름", http.MethodPatch, "/users/2", `{"name": "김개발"}`, http.StatusConflict, "USER_NAME_TAKEN"},
+		{"없는 사용자", http.MethodGet, "/users/999", "", http.StatusNotFound, "USER_NOT_FOUND"},
+		{"잘못된 ID", http.MethodPatch, "/users/abc", `{"name": "최운영"}`, http.StatusBadRequest, "INVALID_ID"},
+		{"없는 역할", http.MethodPatch, "/users/2", `{"role": "guest"}`, http.StatusBadRequest, "VALIDATION_FAILED"},
+		{"자기 자신을 후임자로 지정", http.MethodPost, "/users/1/deactivate", `{"successorId": 1}`, http.StatusBadRequest, "VALIDATION_FAILED"},
+		{"없는 후임자", http.MethodPost, "/users/1/deactivate", `{"successorId": 999}`, http.StatusBadRequest, "USER_NOT_FOUND"},
+		{"없는 사용자 비활성화", http.MethodPost, "/users/999/deactivate", "", http.StatusNotFound, "USER_NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		recorder := do(router, tt.method, tt.path, tt.body)
+		if recorder.Code != tt.status || !strings.Contains(recorder.Body.String(), tt.errorCode) {
+			t.Errorf("%s: %d %s이어야 함. 실제: %d, %s", tt.name, tt.status, tt.errorCode, recorder.Code, recorder.Body.String())
+		}
+	}
+}
+
+func TestUserAPI_비활성화_후임자에게_이슈_넘김(t *testing.T) {
+	router, issueService := setupTestServerWithIssues()
+	userID := uint(1)
+	issue, _ := issueService.CreateIssue("로그인 오류", "설명", &userID)
+
+	recorder := do(router, http.MethodPost, "/users/1/deactivate", `{"successorId": 2}`)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	var response DeactivationResponse
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	if response.User.Active || len(response.IssueIDs) != 1 || response.IssueIDs[0] != issue.ID {
+		t.Errorf("비활성화된 사용자와 넘긴 이슈 ID가 반환되어야 함: %s", recorder.Body.String())
+	}
+	if after, _ := issueService.GetIssueByID(issue.ID); after.User.ID != 2 {
+		t.Errorf("이슈가 후임자에게 넘어가야 함: %+v", after.User)
+	}
+	history, _ := issueService.GetIssueHistory(issue.ID)
+	handOff := history[len(history)-1]
+	if handOff.Actor == nil || handOff.Actor.ID != 1 || handOff.RequestID != recorder.Header().Get(httpx.RequestIDHeader) {
+		t.Errorf("넘긴 이력에 비활성화한 사용자와 요청 ID가 남아야 함: %+v", handOff)
+	}
+
+	// 1번 사용자는 비활성화되어 더 이상 인증할 수 없다
+	recorder = doAs(router, 2, http.MethodGet, "/users/1", "")
+	if !strings.Contains(recorder.Body.String(), `"active":false`) {
+		t.Errorf("사용자 조회에 비활성화 상태가 보여야 함: %s", recorder.Body.String())
+	}
+}
+
+func TestUserAPI_비활성화_후임자가_없으면_담당자_해제(t *testing.T) {
+	router, issueService := setupTestServerWithIssues()
+	userID := uint(1)
+	issue, _ := issueService.CreateIssue("로그인 오류", "설명", &userID)
+
+	recorder := do(router, http.MethodPost, "/users/1/deactivate", "")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("200이어야 함. 실제: %d, %s", recorder.Code, recorder.Body.String())
+	}
+	if after, _ := issueService.GetIssueByID(issue.ID); after.User != nil || after.Status != "PENDING" {
+		t.Errorf("담당자가 해제되고 대기 상태가 되어야 함: %+v", after)
+	}
+
+	recorder = doAs(router, 2, http.MethodPatch, "/users/2", `{"name": "이디자인"}`)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("다른 사용자는 그대로 수정할 수 있어야 함. 실제: %d", recorder.Code)
+	}
+	if _, err := issueService.CreateIssue("새 이슈", "설명", &userID); err == nil {
+		t.Error("비활성화된 사용자에게는 이슈를 할당할 수 없어야 함")
+	}
+}